@@ -31,6 +31,9 @@ const (
 	AuthV3Password AuthType = "v3password"
 	// AuthV3Token defines version 3 of the token
 	AuthV3Token AuthType = "v3token"
+
+	// AuthV3ApplicationCredential defines version 3 of the application credential
+	AuthV3ApplicationCredential AuthType = "v3applicationcredential"
 )
 
 // ClientOpts represents options to customize the way a client is
@@ -54,6 +57,11 @@ type ClientOpts struct {
 	// This will override a region in clouds.yaml or can be used
 	// when authenticating directly with AuthInfo.
 	RegionName string
+
+	// SkipToken ignores any token from clouds.yaml, AuthInfo or the
+	// environment, so that the AuthOptions are built from the remaining
+	// credentials. It is used to obtain a new token once a given one expires.
+	SkipToken bool
 }
 
 // LoadCloudsYAML will load a clouds.yaml file and return the full config.
@@ -327,6 +335,8 @@ func determineIdentityAPI(cloud *Cloud, opts *ClientOpts) string {
 			identityAPI = "3"
 		case AuthV3Token:
 			identityAPI = "3"
+		case AuthV3ApplicationCredential:
+			identityAPI = "3"
 		}
 	}
 
@@ -353,7 +363,9 @@ func v2auth(cloud *Cloud, opts *ClientOpts) (*fic.AuthOptions, error) {
 		}
 	}
 
-	if cloud.AuthInfo.Token == "" {
+	if opts != nil && opts.SkipToken {
+		cloud.AuthInfo.Token = ""
+	} else if cloud.AuthInfo.Token == "" {
 		if v := os.Getenv(envPrefix + "TOKEN"); v != "" {
 			cloud.AuthInfo.Token = v
 		}
@@ -421,7 +433,9 @@ func v3auth(cloud *Cloud, opts *ClientOpts) (*fic.AuthOptions, error) {
 		}
 	}
 
-	if cloud.AuthInfo.Token == "" {
+	if opts != nil && opts.SkipToken {
+		cloud.AuthInfo.Token = ""
+	} else if cloud.AuthInfo.Token == "" {
 		if v := os.Getenv(envPrefix + "TOKEN"); v != "" {
 			cloud.AuthInfo.Token = v
 		}
@@ -511,10 +525,32 @@ func v3auth(cloud *Cloud, opts *ClientOpts) (*fic.AuthOptions, error) {
 		}
 	}
 
+	if cloud.AuthInfo.ApplicationCredentialID == "" {
+		if v := os.Getenv(envPrefix + "APPLICATION_CREDENTIAL_ID"); v != "" {
+			cloud.AuthInfo.ApplicationCredentialID = v
+		}
+	}
+
+	if cloud.AuthInfo.ApplicationCredentialName == "" {
+		if v := os.Getenv(envPrefix + "APPLICATION_CREDENTIAL_NAME"); v != "" {
+			cloud.AuthInfo.ApplicationCredentialName = v
+		}
+	}
+
+	if cloud.AuthInfo.ApplicationCredentialSecret == "" {
+		if v := os.Getenv(envPrefix + "APPLICATION_CREDENTIAL_SECRET"); v != "" {
+			cloud.AuthInfo.ApplicationCredentialSecret = v
+		}
+	}
+
 	// Build a scope and try to do it correctly.
 	scope := new(fic.AuthScope)
 
-	if !isProjectScoped(cloud.AuthInfo) {
+	if isApplicationCredential(cloud) {
+		// Application credentials are bound to a project,
+		// so no scope is sent along with them.
+		cloud = setDomainIfNeeded(cloud)
+	} else if !isProjectScoped(cloud.AuthInfo) {
 		if cloud.AuthInfo.DomainID != "" {
 			scope.DomainID = cloud.AuthInfo.DomainID
 		} else if cloud.AuthInfo.DomainName != "" {
@@ -545,6 +581,10 @@ func v3auth(cloud *Cloud, opts *ClientOpts) (*fic.AuthOptions, error) {
 		TenantName:       cloud.AuthInfo.ProjectName,
 		DomainID:         cloud.AuthInfo.UserDomainID,
 		DomainName:       cloud.AuthInfo.UserDomainName,
+
+		ApplicationCredentialID:     cloud.AuthInfo.ApplicationCredentialID,
+		ApplicationCredentialName:   cloud.AuthInfo.ApplicationCredentialName,
+		ApplicationCredentialSecret: cloud.AuthInfo.ApplicationCredentialSecret,
 	}
 
 	// If an auth_type of "token" was specified, then make sure
@@ -552,12 +592,24 @@ func v3auth(cloud *Cloud, opts *ClientOpts) (*fic.AuthOptions, error) {
 	// unsetting a few other auth options. The reason this is done
 	// here is to wait until all auth settings (both in clouds.yaml
 	// and via environment variables) are set and then unset them.
-	if strings.Contains(string(cloud.AuthType), "token") || ao.TokenID != "" {
+	skipToken := opts != nil && opts.SkipToken
+	if (!skipToken && strings.Contains(string(cloud.AuthType), "token")) || ao.TokenID != "" {
 		ao.Username = ""
 		ao.Password = ""
 		ao.UserID = ""
 		ao.DomainID = ""
 		ao.DomainName = ""
+	} else if isApplicationCredential(cloud) {
+		// go-fic prefers a password over an application credential,
+		// so it has to be unset here as well. An application credential
+		// ID identifies the user on its own.
+		ao.Password = ""
+		if ao.ApplicationCredentialID != "" {
+			ao.Username = ""
+			ao.UserID = ""
+			ao.DomainID = ""
+			ao.DomainName = ""
+		}
 	}
 
 	// Check for absolute minimum requirements.
@@ -663,6 +715,20 @@ func isProjectScoped(authInfo *AuthInfo) bool {
 	return true
 }
 
+// isApplicationCredential determines if a cloud entry authenticates
+// with an application credential.
+func isApplicationCredential(cloud *Cloud) bool {
+	if cloud.AuthType == AuthV3ApplicationCredential {
+		return true
+	}
+
+	if cloud.AuthInfo.ApplicationCredentialID == "" && cloud.AuthInfo.ApplicationCredentialName == "" {
+		return false
+	}
+
+	return true
+}
+
 // setDomainIfNeeded will set a DomainID and DomainName
 // to ProjectDomain* and UserDomain* if not already set.
 func setDomainIfNeeded(cloud *Cloud) *Cloud {
@@ -105,4 +105,16 @@ type AuthInfo struct {
 	// DefaultDomain is the domain ID to fall back on if no other domain has
 	// been specified and a domain is required for scope.
 	DefaultDomain string `yaml:"default_domain"`
+
+	// ApplicationCredentialID is the ID of an application credential.
+	// An application credential ID identifies the user on its own.
+	ApplicationCredentialID string `yaml:"application_credential_id"`
+
+	// ApplicationCredentialName is the name of an application credential.
+	// It must be combined with a Username and either a UserDomainName or
+	// a UserDomainID.
+	ApplicationCredentialName string `yaml:"application_credential_name"`
+
+	// ApplicationCredentialSecret is the secret of an application credential.
+	ApplicationCredentialSecret string `yaml:"application_credential_secret"`
 }
@@ -391,6 +391,77 @@ var TexasAuthOpts = &fic.AuthOptions{
 	DomainName:       "Some Domain",
 }
 
+var UtahCloudYAML = clientconfig.Cloud{
+	RegionName: "SLC",
+	AuthType:   clientconfig.AuthV3ApplicationCredential,
+	AuthInfo: &clientconfig.AuthInfo{
+		AuthURL:                     "https://ut.example.com:5000/v3",
+		ApplicationCredentialID:     "abcde",
+		ApplicationCredentialSecret: "secret",
+	},
+	Verify: &iTrue,
+}
+
+var UtahClientOpts = &clientconfig.ClientOpts{
+	AuthInfo: &clientconfig.AuthInfo{
+		AuthURL:                     "https://ut.example.com:5000/v3",
+		ApplicationCredentialID:     "abcde",
+		ApplicationCredentialSecret: "secret",
+	},
+}
+
+var UtahEnvAuth = map[string]string{
+	"OS_AUTH_URL":                      "https://ut.example.com:5000/v3",
+	"OS_APPLICATION_CREDENTIAL_ID":     "abcde",
+	"OS_APPLICATION_CREDENTIAL_SECRET": "secret",
+}
+
+var UtahAuthOpts = &fic.AuthOptions{
+	Scope:                       &fic.AuthScope{},
+	IdentityEndpoint:            "https://ut.example.com:5000/v3",
+	ApplicationCredentialID:     "abcde",
+	ApplicationCredentialSecret: "secret",
+}
+
+var VirginiaCloudYAML = clientconfig.Cloud{
+	RegionName: "IAD",
+	AuthInfo: &clientconfig.AuthInfo{
+		AuthURL:                     "https://va.example.com:5000/v3",
+		Username:                    "jdoe",
+		UserDomainName:              "Some Domain",
+		ApplicationCredentialName:   "myapp",
+		ApplicationCredentialSecret: "secret",
+	},
+	Verify: &iTrue,
+}
+
+var VirginiaClientOpts = &clientconfig.ClientOpts{
+	AuthInfo: &clientconfig.AuthInfo{
+		AuthURL:                     "https://va.example.com:5000/v3",
+		Username:                    "jdoe",
+		UserDomainName:              "Some Domain",
+		ApplicationCredentialName:   "myapp",
+		ApplicationCredentialSecret: "secret",
+	},
+}
+
+var VirginiaEnvAuth = map[string]string{
+	"OS_AUTH_URL":                      "https://va.example.com:5000/v3",
+	"OS_USERNAME":                      "jdoe",
+	"OS_USER_DOMAIN_NAME":              "Some Domain",
+	"OS_APPLICATION_CREDENTIAL_NAME":   "myapp",
+	"OS_APPLICATION_CREDENTIAL_SECRET": "secret",
+}
+
+var VirginiaAuthOpts = &fic.AuthOptions{
+	Scope:                       &fic.AuthScope{},
+	IdentityEndpoint:            "https://va.example.com:5000/v3",
+	Username:                    "jdoe",
+	DomainName:                  "Some Domain",
+	ApplicationCredentialName:   "myapp",
+	ApplicationCredentialSecret: "secret",
+}
+
 var CloudYAML = clientconfig.Clouds{
 	Clouds: map[string]clientconfig.Cloud{
 		"hawaii":     HawaiiCloudYAML,
@@ -400,6 +471,8 @@ var CloudYAML = clientconfig.Clouds{
 		"newmexico":  NewMexicoCloudYAML,
 		"nevada":     NevadaCloudYAML,
 		"texas":      TexasCloudYAML,
+		"utah":       UtahCloudYAML,
+		"virginia":   VirginiaCloudYAML,
 	},
 }
 
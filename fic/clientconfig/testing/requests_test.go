@@ -27,6 +27,8 @@ func TestGetCloudFromYAML(t *testing.T) {
 		"chicago_legacy":     &clientconfig.ClientOpts{Cloud: "chicago_legacy"},
 		"chicago_useprofile": &clientconfig.ClientOpts{Cloud: "chicago_useprofile"},
 		"philadelphia":       &clientconfig.ClientOpts{Cloud: "philadelphia"},
+		"utah":               &clientconfig.ClientOpts{Cloud: "utah"},
+		"virginia":           &clientconfig.ClientOpts{Cloud: "virginia"},
 	}
 
 	expectedClouds := map[string]*clientconfig.Cloud{
@@ -38,6 +40,8 @@ func TestGetCloudFromYAML(t *testing.T) {
 		"chicago_legacy":     &ChicagoCloudLegacyYAML,
 		"chicago_useprofile": &ChicagoCloudUseProfileYAML,
 		"philadelphia":       &PhiladelphiaCloudYAML,
+		"utah":               &UtahCloudYAML,
+		"virginia":           &VirginiaCloudYAML,
 	}
 
 	for cloud, clientOpts := range allClientOpts {
@@ -90,6 +94,8 @@ func TestAuthOptionsCreationFromCloudsYAML(t *testing.T) {
 		"newmexico":  NewMexicoAuthOpts,
 		"nevada":     NevadaAuthOpts,
 		"texas":      TexasAuthOpts,
+		"utah":       UtahAuthOpts,
+		"virginia":   VirginiaAuthOpts,
 	}
 
 	for cloud, expected := range allClouds {
@@ -142,6 +148,8 @@ func TestAuthOptionsCreationFromClientConfig(t *testing.T) {
 		"newmexico":  NewMexicoAuthOpts,
 		"nevada":     NevadaAuthOpts,
 		"texas":      TexasAuthOpts,
+		"utah":       UtahAuthOpts,
+		"virginia":   VirginiaAuthOpts,
 	}
 
 	allClientOpts := map[string]*clientconfig.ClientOpts{
@@ -152,6 +160,8 @@ func TestAuthOptionsCreationFromClientConfig(t *testing.T) {
 		"newmexico":  NewMexicoClientOpts,
 		"nevada":     NevadaClientOpts,
 		"texas":      TexasClientOpts,
+		"utah":       UtahClientOpts,
+		"virginia":   VirginiaClientOpts,
 	}
 
 	for cloud, clientOpts := range allClientOpts {
@@ -192,6 +202,8 @@ func TestAuthOptionsCreationFromEnv(t *testing.T) {
 		"newmexico":  NewMexicoEnvAuth,
 		"nevada":     NevadaEnvAuth,
 		"texas":      TexasEnvAuth,
+		"utah":       UtahEnvAuth,
+		"virginia":   VirginiaEnvAuth,
 	}
 
 	expectedAuthOpts := map[string]*fic.AuthOptions{
@@ -202,6 +214,8 @@ func TestAuthOptionsCreationFromEnv(t *testing.T) {
 		"newmexico":  NewMexicoAuthOpts,
 		"nevada":     NevadaAuthOpts,
 		"texas":      TexasAuthOpts,
+		"utah":       UtahAuthOpts,
+		"virginia":   VirginiaAuthOpts,
 	}
 
 	for cloud, envVars := range allEnvVars {
@@ -0,0 +1,97 @@
+package testing
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/nttcom/go-fic"
+	"github.com/nttcom/go-fic/fic/identity/v3/tokens"
+	"github.com/nttcom/terraform-provider-fic/fic/clientconfig"
+
+	th "github.com/nttcom/go-fic/testhelper"
+)
+
+func TestTokenCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fic-token-cache")
+	th.AssertNoErr(t, err)
+	defer os.RemoveAll(dir)
+
+	tc := &clientconfig.TokenCache{Dir: dir}
+	ao := &fic.AuthOptions{
+		IdentityEndpoint: "https://example.com:5000/v3",
+		Username:         "jdoe",
+		Password:         "password",
+		DomainName:       "Default",
+		TenantName:       "Some Project",
+	}
+
+	actual, err := tc.Get(ao)
+	th.AssertNoErr(t, err)
+	if actual != nil {
+		t.Fatalf("Expected no cached token, got %#v", actual)
+	}
+
+	expected := &clientconfig.CachedToken{
+		ID:        "abcdef",
+		ExpiresAt: time.Now().Add(time.Hour).UTC().Round(time.Second),
+		Catalog: &tokens.ServiceCatalog{
+			Entries: []tokens.CatalogEntry{
+				{Type: "fic-eri", Endpoints: []tokens.Endpoint{{URL: "https://eri.example.com/"}}},
+			},
+		},
+	}
+	th.AssertNoErr(t, tc.Put(ao, expected))
+
+	actual, err = tc.Get(ao)
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, expected, actual)
+
+	// A different password must hit the same entry.
+	samePrincipal := *ao
+	samePrincipal.Password = "other"
+	actual, err = tc.Get(&samePrincipal)
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, expected, actual)
+
+	otherUser := *ao
+	otherUser.Username = "jsmith"
+	actual, err = tc.Get(&otherUser)
+	th.AssertNoErr(t, err)
+	if actual != nil {
+		t.Fatalf("Expected no cached token for another user, got %#v", actual)
+	}
+
+	th.AssertNoErr(t, tc.Delete(ao))
+	actual, err = tc.Get(ao)
+	th.AssertNoErr(t, err)
+	if actual != nil {
+		t.Fatalf("Expected the cached token to be deleted, got %#v", actual)
+	}
+}
+
+func TestTokenCacheExpired(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fic-token-cache")
+	th.AssertNoErr(t, err)
+	defer os.RemoveAll(dir)
+
+	tc := &clientconfig.TokenCache{Dir: dir}
+	ao := &fic.AuthOptions{
+		IdentityEndpoint: "https://example.com:5000/v3",
+		Username:         "jdoe",
+	}
+
+	token := &clientconfig.CachedToken{
+		ID:        "abcdef",
+		ExpiresAt: time.Now().Add(clientconfig.DefaultTokenExpiryMargin / 2),
+		Catalog:   &tokens.ServiceCatalog{},
+	}
+	th.AssertNoErr(t, tc.Put(ao, token))
+
+	actual, err := tc.Get(ao)
+	th.AssertNoErr(t, err)
+	if actual != nil {
+		t.Fatalf("Expected a token within the expiry margin to be ignored, got %#v", actual)
+	}
+}
@@ -0,0 +1,140 @@
+package clientconfig
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/nttcom/go-fic"
+	"github.com/nttcom/go-fic/fic/identity/v3/tokens"
+)
+
+// DefaultTokenExpiryMargin is the time before its expiration at which a
+// cached token is no longer handed out.
+const DefaultTokenExpiryMargin = 5 * time.Minute
+
+// TokenCache stores Keystone tokens on disk, so that they can be reused
+// by subsequent runs until they expire.
+// Entries are keyed by the auth URL, the user and the project.
+type TokenCache struct {
+	// Dir is the directory the tokens are stored in.
+	// It is created with 0700 permissions if it does not exist.
+	Dir string
+
+	// ExpiryMargin overrides DefaultTokenExpiryMargin if set.
+	ExpiryMargin time.Duration
+}
+
+// CachedToken represents a token and the service catalog
+// which was issued along with it.
+type CachedToken struct {
+	ID        string                 `json:"id"`
+	ExpiresAt time.Time              `json:"expires_at"`
+	Catalog   *tokens.ServiceCatalog `json:"catalog"`
+}
+
+// Get returns the cached token for the given AuthOptions.
+// A nil token is returned if there is no entry or if the entry expires
+// within the expiry margin.
+func (tc *TokenCache) Get(ao *fic.AuthOptions) (*CachedToken, error) {
+	b, err := ioutil.ReadFile(tc.path(ao))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var token CachedToken
+	if err := json.Unmarshal(b, &token); err != nil {
+		return nil, err
+	}
+
+	margin := tc.ExpiryMargin
+	if margin == 0 {
+		margin = DefaultTokenExpiryMargin
+	}
+
+	if token.ID == "" || token.Catalog == nil || time.Now().Add(margin).After(token.ExpiresAt) {
+		return nil, nil
+	}
+
+	return &token, nil
+}
+
+// Put stores a token for the given AuthOptions.
+// The file is written with 0600 permissions and replaced atomically.
+func (tc *TokenCache) Put(ao *fic.AuthOptions, token *CachedToken) error {
+	b, err := json.Marshal(token)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(tc.Dir, 0700); err != nil {
+		return err
+	}
+
+	f, err := ioutil.TempFile(tc.Dir, ".token-")
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+
+	return os.Rename(f.Name(), tc.path(ao))
+}
+
+// Delete removes the cached token for the given AuthOptions, if any.
+func (tc *TokenCache) Delete(ao *fic.AuthOptions) error {
+	err := os.Remove(tc.path(ao))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (tc *TokenCache) path(ao *fic.AuthOptions) string {
+	return filepath.Join(tc.Dir, tokenCacheKey(ao)+".json")
+}
+
+// tokenCacheKey derives a file name from everything which identifies the
+// auth URL, the user and the project of a token. Secrets are not part of it.
+func tokenCacheKey(ao *fic.AuthOptions) string {
+	parts := []string{
+		ao.IdentityEndpoint,
+		ao.UserID,
+		ao.Username,
+		ao.DomainID,
+		ao.DomainName,
+		ao.ApplicationCredentialID,
+		ao.ApplicationCredentialName,
+		ao.TenantID,
+		ao.TenantName,
+	}
+
+	if ao.Scope != nil {
+		parts = append(parts,
+			ao.Scope.ProjectID,
+			ao.Scope.ProjectName,
+			ao.Scope.DomainID,
+			ao.Scope.DomainName,
+		)
+	}
+
+	sum := sha256.Sum256([]byte(strings.Join(parts, "\x00")))
+	return hex.EncodeToString(sum[:])
+}
@@ -5,9 +5,10 @@ import (
 	"crypto/x509"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-sdk/httpclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/meta"
 
 	"github.com/nttcom/go-fic"
+	tokens3 "github.com/nttcom/go-fic/fic/identity/v3/tokens"
 	"github.com/nttcom/go-fic/fic/utils"
 
 	"github.com/nttcom/terraform-provider-fic/fic/clientconfig"
@@ -15,34 +16,88 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"strings"
+	"sync"
+	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/pathorcontents"
+	"github.com/unknwon/com"
+	"golang.org/x/time/rate"
 )
 
+// go-fic only defines the public availability, so the remaining catalog
+// interfaces are declared here.
+const (
+	availabilityAdmin    fic.Availability = "admin"
+	availabilityInternal fic.Availability = "internal"
+)
+
+type tenantRateLimit struct {
+	limiter *rate.Limiter
+	slots   chan struct{}
+}
+
+var (
+	tenantRateLimitsMu sync.Mutex
+	tenantRateLimits   = make(map[string]tenantRateLimit)
+)
+
+// validEndpointOverrides lists the services whose endpoint can be set
+// through the endpoint_overrides provider argument.
+var validEndpointOverrides = []string{"eri"}
+
+// configError reports an invalid provider argument, so that the diagnostic
+// returned by the provider points at the argument.
+type configError struct {
+	Attribute string
+	Err       error
+}
+
+func newConfigError(attribute string, format string, a ...interface{}) error {
+	return &configError{
+		Attribute: attribute,
+		Err:       fmt.Errorf(format, a...),
+	}
+}
+
+func (e *configError) Error() string {
+	return e.Err.Error()
+}
+
 type Config struct {
-	CACertFile        string
-	ClientCertFile    string
-	ClientKeyFile     string
-	Cloud             string
-	DefaultDomain     string
-	DomainID          string
-	DomainName        string
-	EndpointType      string
-	ForceSSSEndpoint  string
-	IdentityEndpoint  string
-	Insecure          *bool
-	Password          string
-	ProjectDomainName string
-	ProjectDomainID   string
-	Region            string
-	TenantID          string
-	TenantName        string
-	Token             string
-	UserDomainName    string
-	UserDomainID      string
-	Username          string
-	UserID            string
-	terraformVersion  string
+	ApplicationCredentialID     string
+	ApplicationCredentialName   string
+	ApplicationCredentialSecret string
+	CACertFile                  string
+	ClientCertFile              string
+	ClientKeyFile               string
+	Cloud                       string
+	DefaultDomain               string
+	DomainID                    string
+	DomainName                  string
+	EndpointOverrides           map[string]string
+	EndpointType                string
+	ForceSSSEndpoint            string
+	IdentityEndpoint            string
+	Insecure                    *bool
+	MaxConcurrent               int
+	MaxRetries                  int
+	Password                    string
+	ProjectDomainName           string
+	ProjectDomainID             string
+	RedactLogKeys               []string
+	Region                      string
+	RequestsPerSecond           float64
+	RetryMaxBackoff             time.Duration
+	RetryMinBackoff             time.Duration
+	TenantID                    string
+	TenantName                  string
+	Token                       string
+	TokenCacheDir               string
+	UserDomainName              string
+	UserDomainID                string
+	Username                    string
+	UserID                      string
+	terraformVersion            string
 
 	OsClient *fic.ProviderClient
 }
@@ -50,7 +105,7 @@ type Config struct {
 func (c *Config) LoadAndValidate() error {
 	// Make sure at least one of auth_url or cloud was specified.
 	if c.IdentityEndpoint == "" && c.Cloud == "" {
-		return fmt.Errorf("One of 'auth_url' or 'cloud' must be specified")
+		return newConfigError("auth_url", "One of 'auth_url' or 'cloud' must be specified")
 	}
 
 	validEndpoint := false
@@ -68,7 +123,30 @@ func (c *Config) LoadAndValidate() error {
 	}
 
 	if !validEndpoint {
-		return fmt.Errorf("Invalid endpoint type provided")
+		return newConfigError("endpoint_type", "Invalid endpoint type provided")
+	}
+
+	if c.MaxRetries < 0 {
+		return newConfigError("max_retries", "max_retries must not be negative")
+	}
+
+	if c.MaxConcurrent < 0 {
+		return newConfigError("max_concurrent_requests", "max_concurrent_requests must not be negative")
+	}
+
+	if c.RequestsPerSecond < 0 {
+		return newConfigError("requests_per_second", "requests_per_second must not be negative")
+	}
+
+	if c.RetryMinBackoff > c.RetryMaxBackoff {
+		return newConfigError("retry_min_backoff", "retry_min_backoff must not be greater than retry_max_backoff")
+	}
+
+	for service := range c.EndpointOverrides {
+		if !com.IsSliceContainsStr(validEndpointOverrides, service) {
+			return newConfigError("endpoint_overrides", "Invalid endpoint override provided for service %q, must be one of %s",
+				service, strings.Join(validEndpointOverrides, ", "))
+		}
 	}
 
 	clientOpts := new(clientconfig.ClientOpts)
@@ -104,20 +182,23 @@ func (c *Config) LoadAndValidate() error {
 		}
 	} else {
 		authInfo := &clientconfig.AuthInfo{
-			AuthURL:           c.IdentityEndpoint,
-			DefaultDomain:     c.DefaultDomain,
-			DomainID:          c.DomainID,
-			DomainName:        c.DomainName,
-			Password:          c.Password,
-			ProjectDomainID:   c.ProjectDomainID,
-			ProjectDomainName: c.ProjectDomainName,
-			ProjectID:         c.TenantID,
-			ProjectName:       c.TenantName,
-			Token:             c.Token,
-			UserDomainID:      c.UserDomainID,
-			UserDomainName:    c.UserDomainName,
-			Username:          c.Username,
-			UserID:            c.UserID,
+			ApplicationCredentialID:     c.ApplicationCredentialID,
+			ApplicationCredentialName:   c.ApplicationCredentialName,
+			ApplicationCredentialSecret: c.ApplicationCredentialSecret,
+			AuthURL:                     c.IdentityEndpoint,
+			DefaultDomain:               c.DefaultDomain,
+			DomainID:                    c.DomainID,
+			DomainName:                  c.DomainName,
+			Password:                    c.Password,
+			ProjectDomainID:             c.ProjectDomainID,
+			ProjectDomainName:           c.ProjectDomainName,
+			ProjectID:                   c.TenantID,
+			ProjectName:                 c.TenantName,
+			Token:                       c.Token,
+			UserDomainID:                c.UserDomainID,
+			UserDomainName:              c.UserDomainName,
+			Username:                    c.Username,
+			UserID:                      c.UserID,
 		}
 		clientOpts.AuthInfo = authInfo
 	}
@@ -127,19 +208,21 @@ func (c *Config) LoadAndValidate() error {
 		return err
 	}
 
+	reauthOpts := reauthOptions(clientOpts, ao)
+
 	client, err := utils.NewClient(ao.IdentityEndpoint)
 	if err != nil {
 		return err
 	}
 
 	// Set UserAgent
-	client.UserAgent.Prepend(httpclient.TerraformUserAgent(c.terraformVersion))
+	client.UserAgent.Prepend(terraformUserAgent(c.terraformVersion))
 
 	config := &tls.Config{}
 	if c.CACertFile != "" {
-		caCert, _, err := pathorcontents.Read(c.CACertFile)
+		caCert, _, err := readPathOrContents(c.CACertFile)
 		if err != nil {
-			return fmt.Errorf("Error reading CA Cert: %s", err)
+			return newConfigError("cacert_file", "Error reading CA Cert: %s", err)
 		}
 
 		caCertPool := x509.NewCertPool()
@@ -154,13 +237,13 @@ func (c *Config) LoadAndValidate() error {
 	}
 
 	if c.ClientCertFile != "" && c.ClientKeyFile != "" {
-		clientCert, _, err := pathorcontents.Read(c.ClientCertFile)
+		clientCert, _, err := readPathOrContents(c.ClientCertFile)
 		if err != nil {
-			return fmt.Errorf("Error reading Client Cert: %s", err)
+			return newConfigError("cert", "Error reading Client Cert: %s", err)
 		}
-		clientKey, _, err := pathorcontents.Read(c.ClientKeyFile)
+		clientKey, _, err := readPathOrContents(c.ClientKeyFile)
 		if err != nil {
-			return fmt.Errorf("Error reading Client Key: %s", err)
+			return newConfigError("key", "Error reading Client Key: %s", err)
 		}
 
 		cert, err := tls.X509KeyPair([]byte(clientCert), []byte(clientKey))
@@ -179,14 +262,25 @@ func (c *Config) LoadAndValidate() error {
 	}
 
 	transport := &http.Transport{Proxy: http.ProxyFromEnvironment, TLSClientConfig: config}
+	limiter, slots := c.tenantRateLimit(ao)
 	client.HTTPClient = http.Client{
-		Transport: &LogRoundTripper{
-			Rt:      transport,
-			OsDebug: osDebug,
+		Transport: &RetryRoundTripper{
+			Rt: &RateLimitRoundTripper{
+				Rt: &LogRoundTripper{
+					Rt:         transport,
+					OsDebug:    osDebug,
+					RedactKeys: c.RedactLogKeys,
+				},
+				Limiter: limiter,
+				Slots:   slots,
+			},
+			MaxRetries: c.MaxRetries,
+			MinBackoff: c.RetryMinBackoff,
+			MaxBackoff: c.RetryMaxBackoff,
 		},
 	}
 
-	err = utils.Authenticate(client, *ao)
+	err = c.authenticate(client, *ao, reauthOpts)
 	if err != nil {
 		return err
 	}
@@ -195,6 +289,13 @@ func (c *Config) LoadAndValidate() error {
 	return nil
 }
 
+// terraformUserAgent returns the User-Agent the SDK v1 httpclient package used
+// to build, so that the FIC API sees the same product tokens as before.
+func terraformUserAgent(version string) string {
+	return fmt.Sprintf("HashiCorp Terraform/%s (+https://www.terraform.io) Terraform Plugin SDK/%s",
+		version, meta.SDKVersionString())
+}
+
 func (c *Config) determineRegion(region string) string {
 	// If a resource-level region was not specified, and a provider-level region was set,
 	// use the provider-level region.
@@ -206,13 +307,290 @@ func (c *Config) determineRegion(region string) string {
 	return region
 }
 
+// tenantRateLimit returns the token bucket and the concurrency slots shared
+// by every client which authenticates against the same tenant with the same
+// limits, so that aliased provider configurations draw from one budget.
+func (c *Config) tenantRateLimit(ao *fic.AuthOptions) (*rate.Limiter, chan struct{}) {
+	if c.RequestsPerSecond == 0 && c.MaxConcurrent == 0 {
+		return nil, nil
+	}
+
+	key := fmt.Sprintf("%s|%s|%s|%g|%d", ao.IdentityEndpoint, ao.TenantID, ao.TenantName,
+		c.RequestsPerSecond, c.MaxConcurrent)
+
+	tenantRateLimitsMu.Lock()
+	defer tenantRateLimitsMu.Unlock()
+
+	if l, ok := tenantRateLimits[key]; ok {
+		return l.limiter, l.slots
+	}
+
+	l := tenantRateLimit{}
+	if c.RequestsPerSecond > 0 {
+		l.limiter = rate.NewLimiter(rate.Limit(c.RequestsPerSecond), 1)
+	}
+	if c.MaxConcurrent > 0 {
+		l.slots = make(chan struct{}, c.MaxConcurrent)
+	}
+	tenantRateLimits[key] = l
+
+	return l.limiter, l.slots
+}
+
+// authenticate obtains a token from the identity service and installs an
+// endpoint locator on the client. Unlike utils.Authenticate, the locator
+// honors every catalog interface, and force_sss_endpoint takes precedence
+// over the identity endpoint discovered from auth_url.
+// If token_cache_dir is set, a cached token is reused instead of issuing
+// a new one, and newly issued tokens are written to the cache.
+// If reauthOpts is not nil, the client obtains a new token with them
+// whenever a request is rejected with 401.
+func (c *Config) authenticate(client *fic.ProviderClient, ao fic.AuthOptions, reauthOpts *fic.AuthOptions) error {
+	cache := c.tokenCache(&ao)
+
+	var cached *clientconfig.CachedToken
+	if cache != nil {
+		var err error
+		cached, err = cache.Get(&ao)
+		if err != nil {
+			log.Printf("[WARN] Unable to read the token cache: %s", err)
+		}
+	}
+
+	if cached != nil {
+		log.Printf("[DEBUG] Using a cached FIC token which expires at %s", cached.ExpiresAt)
+		client.SetToken(cached.ID)
+		client.EndpointLocator = func(opts fic.EndpointOpts) (string, error) {
+			return v3EndpointURL(cached.Catalog, opts)
+		}
+	} else {
+		token, catalog, err := c.issueToken(client, ao)
+		if err != nil {
+			return err
+		}
+
+		client.SetToken(token.ID)
+		client.EndpointLocator = func(opts fic.EndpointOpts) (string, error) {
+			return v3EndpointURL(catalog, opts)
+		}
+
+		cacheToken(cache, &ao, token, catalog)
+	}
+
+	if reauthOpts != nil {
+		c.setReauthFunc(client, *reauthOpts)
+	}
+
+	return nil
+}
+
+// issueToken requests a new token from the identity service.
+func (c *Config) issueToken(client *fic.ProviderClient, ao fic.AuthOptions) (*tokens3.Token, *tokens3.ServiceCatalog, error) {
+	identityClient, err := c.identityV3Client(client)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := tokens3.Create(identityClient, &ao)
+
+	token, err := result.ExtractToken()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	catalog, err := result.ExtractServiceCatalog()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return token, catalog, nil
+}
+
+// setReauthFunc makes the client obtain a new token with the given options
+// once the current one is rejected, so that long running applies survive
+// the expiration of the token.
+func (c *Config) setReauthFunc(client *fic.ProviderClient, ao fic.AuthOptions) {
+	cache := c.tokenCache(&ao)
+
+	// The new token is issued by a throw-away copy of the client which has
+	// neither a token nor a reauth func, so that it is tried only once.
+	tac := *client
+	tac.ReauthFunc = nil
+	tac.TokenID = ""
+
+	client.ReauthFunc = func() error {
+		log.Printf("[DEBUG] The FIC token was rejected, re-authenticating")
+
+		// The cached token may be the rejected one.
+		if cache != nil {
+			if err := cache.Delete(&ao); err != nil {
+				log.Printf("[WARN] Unable to delete the cached token: %s", err)
+			}
+		}
+
+		token, catalog, err := c.issueToken(&tac, ao)
+		if err != nil {
+			return err
+		}
+
+		// Reauthenticate holds the token lock while calling this function,
+		// so the token can not be set through SetToken.
+		client.TokenID = token.ID
+
+		cacheToken(cache, &ao, token, catalog)
+
+		return nil
+	}
+}
+
+// tokenCache returns the on-disk token cache, or nil if caching is disabled.
+// Tokens passed in through the token argument are never cached.
+func (c *Config) tokenCache(ao *fic.AuthOptions) *clientconfig.TokenCache {
+	if c.TokenCacheDir == "" || ao.TokenID != "" {
+		return nil
+	}
+
+	return &clientconfig.TokenCache{Dir: c.TokenCacheDir}
+}
+
+func cacheToken(cache *clientconfig.TokenCache, ao *fic.AuthOptions, token *tokens3.Token, catalog *tokens3.ServiceCatalog) {
+	if cache == nil {
+		return
+	}
+
+	err := cache.Put(ao, &clientconfig.CachedToken{
+		ID:        token.ID,
+		ExpiresAt: token.ExpiresAt,
+		Catalog:   catalog,
+	})
+	if err != nil {
+		log.Printf("[WARN] Unable to write the token cache: %s", err)
+	}
+}
+
+// reauthOptions returns the options to obtain a new token with once the
+// current one expires, or nil if the credentials do not allow it.
+// A token given through the token argument can not renew itself,
+// so a password or an application credential given along with it is used.
+func reauthOptions(clientOpts *clientconfig.ClientOpts, ao *fic.AuthOptions) *fic.AuthOptions {
+	if ao.TokenID == "" {
+		return ao
+	}
+
+	opts := *clientOpts
+	opts.SkipToken = true
+	if opts.AuthInfo != nil {
+		authInfo := *opts.AuthInfo
+		opts.AuthInfo = &authInfo
+	}
+
+	fallback, err := clientconfig.AuthOptions(&opts)
+	if err != nil || (fallback.Password == "" && fallback.ApplicationCredentialSecret == "") {
+		log.Printf("[DEBUG] No fallback credentials were given, the FIC token can not be renewed")
+		return nil
+	}
+
+	return fallback
+}
+
+// v3EndpointURL discovers the endpoint URL for a service from the catalog
+// returned by the identity service. Admin and internal interfaces are
+// accepted in addition to the public one.
+func v3EndpointURL(catalog *tokens3.ServiceCatalog, opts fic.EndpointOpts) (string, error) {
+	availability := opts.Availability
+	if availability == "" {
+		availability = fic.AvailabilityPublic
+	}
+
+	var endpoints []tokens3.Endpoint
+	for _, entry := range catalog.Entries {
+		if entry.Type != opts.Type || (opts.Name != "" && entry.Name != opts.Name) {
+			continue
+		}
+
+		for _, endpoint := range entry.Endpoints {
+			if fic.Availability(endpoint.Interface) != availability {
+				continue
+			}
+
+			if opts.Region == "" || endpoint.Region == opts.Region || endpoint.RegionID == opts.Region {
+				endpoints = append(endpoints, endpoint)
+			}
+		}
+	}
+
+	if len(endpoints) > 1 {
+		return "", utils.ErrMultipleMatchingEndpointsV3{Endpoints: endpoints}
+	}
+
+	if len(endpoints) == 0 {
+		return "", &fic.ErrEndpointNotFound{}
+	}
+
+	return fic.NormalizeURL(endpoints[0].URL), nil
+}
+
 func (c *Config) getEndpointType() fic.Availability {
+	switch c.EndpointType {
+	case "internal", "internalURL":
+		return availabilityInternal
+	case "admin", "adminURL":
+		return availabilityAdmin
+	}
+
 	return fic.AvailabilityPublic
 }
 
+// identityV3Client returns a client of the v3 identity service the given
+// provider client authenticates against. force_sss_endpoint takes precedence
+// over the identity endpoint discovered from auth_url.
+func (c *Config) identityV3Client(client *fic.ProviderClient) (*fic.ServiceClient, error) {
+	endpoint := c.ForceSSSEndpoint
+	if endpoint == "" {
+		versions := []*utils.Version{
+			{ID: "v3", Priority: 30, Suffix: "/v3/"},
+		}
+
+		_, chosen, err := utils.ChooseVersion(client, versions)
+		if err != nil {
+			return nil, err
+		}
+		endpoint = chosen
+	}
+
+	return &fic.ServiceClient{
+		ProviderClient: client,
+		Endpoint:       fic.NormalizeURL(endpoint),
+		Type:           "identity",
+	}, nil
+}
+
 func (c *Config) eriV1Client(region string) (*fic.ServiceClient, error) {
-	return utils.NewEriV1(c.OsClient, fic.EndpointOpts{
+	// An explicit override bypasses the service catalog entirely.
+	if v := c.EndpointOverrides["eri"]; v != "" {
+		return &fic.ServiceClient{
+			ProviderClient: c.OsClient,
+			Endpoint:       fic.NormalizeURL(v),
+			Type:           "fic-eri",
+		}, nil
+	}
+
+	endpoint, err := c.OsClient.EndpointLocator(fic.EndpointOpts{
+		Type:         "fic-eri",
 		Region:       c.determineRegion(region),
 		Availability: c.getEndpointType(),
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	if v := os.Getenv("STATIC_FIC_ERI_ENDPOINT"); v != "" {
+		endpoint = v
+	}
+
+	return &fic.ServiceClient{
+		ProviderClient: c.OsClient,
+		Endpoint:       endpoint + "v1/",
+		Type:           "fic-eri",
+	}, nil
 }
@@ -0,0 +1,346 @@
+package fic
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/nttcom/go-fic"
+
+	"github.com/nttcom/terraform-provider-fic/fic/clientconfig"
+	"github.com/nttcom/terraform-provider-fic/fic/testhelper/mock"
+)
+
+// testConfigWithMockKeystone returns a config authenticating against a mock
+// keystone. Additional mocks can be registered by register before the mock
+// server starts.
+func testConfigWithMockKeystone(t *testing.T, register ...func(mc *mock.MockController)) (*mock.MockController, *Config) {
+	mc := mock.NewMockController()
+
+	mc.Register(t, "keystone", "/v3/auth/tokens", fmt.Sprintf(fakeKeystonePostEriTmpl, mc.Endpoint()))
+	for _, r := range register {
+		r(mc)
+	}
+	mc.StartServer(t)
+
+	config := &Config{
+		IdentityEndpoint: mc.Endpoint() + "v3/",
+		Username:         "ThisIsADummyTenantUsername",
+		Password:         "ThisIsADummyPassword",
+		TenantID:         "01234567890123456789abcdefabcdef",
+		DomainID:         "default",
+	}
+
+	return mc, config
+}
+
+func TestConfigEriV1Client_endpointType(t *testing.T) {
+	cases := map[string]string{
+		"":            "public/v1/",
+		"public":      "public/v1/",
+		"publicURL":   "public/v1/",
+		"internal":    "internal/v1/",
+		"internalURL": "internal/v1/",
+		"admin":       "admin/v1/",
+		"adminURL":    "admin/v1/",
+	}
+
+	for endpointType, suffix := range cases {
+		mc, config := testConfigWithMockKeystone(t)
+		config.EndpointType = endpointType
+
+		if err := config.LoadAndValidate(); err != nil {
+			mc.TerminateMockControllerSafety()
+			t.Fatalf("Unexpected error for endpoint_type %q: %s", endpointType, err)
+		}
+
+		client, err := config.eriV1Client("")
+		if err != nil {
+			mc.TerminateMockControllerSafety()
+			t.Fatalf("Unable to create ERI client for endpoint_type %q: %s", endpointType, err)
+		}
+
+		expected := mc.Endpoint() + suffix
+		if client.Endpoint != expected {
+			t.Errorf("Expected endpoint %s for endpoint_type %q, got %s", expected, endpointType, client.Endpoint)
+		}
+
+		mc.TerminateMockControllerSafety()
+	}
+}
+
+func TestConfigEriV1Client_invalidEndpointType(t *testing.T) {
+	mc, config := testConfigWithMockKeystone(t)
+	defer mc.TerminateMockControllerSafety()
+
+	config.EndpointType = "private"
+
+	if err := config.LoadAndValidate(); err == nil {
+		t.Fatal("Expected an error for an invalid endpoint_type")
+	}
+}
+
+func TestConfigLoadAndValidate_forceSSSEndpoint(t *testing.T) {
+	mc, config := testConfigWithMockKeystone(t)
+	defer mc.TerminateMockControllerSafety()
+
+	// auth_url points at a server that does not exist, so authentication
+	// only succeeds when force_sss_endpoint is honored.
+	config.IdentityEndpoint = "http://127.0.0.1:0/v3/"
+	config.ForceSSSEndpoint = mc.Endpoint() + "v3/"
+
+	if err := config.LoadAndValidate(); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if config.OsClient.Token() != mock.FakeTokenID {
+		t.Errorf("Expected token %s, got %s", mock.FakeTokenID, config.OsClient.Token())
+	}
+
+	client, err := config.eriV1Client("")
+	if err != nil {
+		t.Fatalf("Unable to create ERI client: %s", err)
+	}
+
+	if expected := mc.Endpoint() + "public/v1/"; client.Endpoint != expected {
+		t.Errorf("Expected endpoint %s, got %s", expected, client.Endpoint)
+	}
+}
+
+func TestConfigEriV1Client_endpointOverride(t *testing.T) {
+	mc, config := testConfigWithMockKeystone(t)
+	defer mc.TerminateMockControllerSafety()
+
+	config.EndpointOverrides = map[string]string{
+		"eri": "https://fic-proxy.local/v1",
+	}
+
+	if err := config.LoadAndValidate(); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	client, err := config.eriV1Client("")
+	if err != nil {
+		t.Fatalf("Unable to create ERI client: %s", err)
+	}
+
+	if expected := "https://fic-proxy.local/v1/"; client.Endpoint != expected {
+		t.Errorf("Expected endpoint %s, got %s", expected, client.Endpoint)
+	}
+}
+
+func TestConfigLoadAndValidate_invalidEndpointOverride(t *testing.T) {
+	mc, config := testConfigWithMockKeystone(t)
+	defer mc.TerminateMockControllerSafety()
+
+	config.EndpointOverrides = map[string]string{
+		"compute": "https://fic-proxy.local/v1/",
+	}
+
+	if err := config.LoadAndValidate(); err == nil {
+		t.Fatal("Expected an error for an unknown endpoint override")
+	}
+}
+
+func TestConfigTenantRateLimit(t *testing.T) {
+	ao := &fic.AuthOptions{
+		IdentityEndpoint: "https://example.com/v3/",
+		TenantID:         "01234567890123456789abcdefabcdef",
+	}
+
+	config := &Config{}
+	if limiter, slots := config.tenantRateLimit(ao); limiter != nil || slots != nil {
+		t.Fatal("Expected no limits when none are configured")
+	}
+
+	config = &Config{RequestsPerSecond: 2, MaxConcurrent: 4}
+	limiter, slots := config.tenantRateLimit(ao)
+	if limiter == nil || slots == nil {
+		t.Fatal("Expected limits to be configured")
+	}
+
+	if cap(slots) != 4 {
+		t.Errorf("Expected 4 concurrency slots, got %d", cap(slots))
+	}
+
+	other := &Config{RequestsPerSecond: 2, MaxConcurrent: 4}
+	otherLimiter, otherSlots := other.tenantRateLimit(ao)
+	if otherLimiter != limiter || otherSlots != slots {
+		t.Error("Expected clients of the same tenant to share limits")
+	}
+
+	otherTenant := *ao
+	otherTenant.TenantID = "abcdef0123456789abcdef0123456789"
+	otherLimiter, otherSlots = other.tenantRateLimit(&otherTenant)
+	if otherLimiter == limiter || otherSlots == slots {
+		t.Error("Expected clients of different tenants not to share limits")
+	}
+}
+
+func TestConfigLoadAndValidate_tokenCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fic-token-cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	mc := mock.NewMockController()
+	keystone := strings.Replace(fmt.Sprintf(fakeKeystonePostEriTmpl, mc.Endpoint()),
+		"2018-11-28T02:48:52.111201Z", "2099-11-28T02:48:52.111201Z", 1)
+	mc.Register(t, "keystone", "/v3/auth/tokens", keystone)
+	mc.StartServer(t)
+
+	newConfig := func() *Config {
+		return &Config{
+			IdentityEndpoint: mc.Endpoint() + "v3/",
+			Username:         "ThisIsADummyTenantUsername",
+			Password:         "ThisIsADummyPassword",
+			TenantID:         "01234567890123456789abcdefabcdef",
+			DomainID:         "default",
+			TokenCacheDir:    dir,
+		}
+	}
+
+	if err := newConfig().LoadAndValidate(); err != nil {
+		mc.TerminateMockControllerSafety()
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	// The identity service is no longer reachable,
+	// so the second client has to use the cached token.
+	mc.TerminateMockControllerSafety()
+
+	config := newConfig()
+	if err := config.LoadAndValidate(); err != nil {
+		t.Fatalf("Expected the cached token to be used, got: %s", err)
+	}
+
+	if config.OsClient.TokenID != mock.FakeTokenID {
+		t.Errorf("Expected token %s, got %s", mock.FakeTokenID, config.OsClient.TokenID)
+	}
+
+	client, err := config.eriV1Client("")
+	if err != nil {
+		t.Fatalf("Unable to create ERI client from the cached catalog: %s", err)
+	}
+
+	if expected := mc.Endpoint() + "public/v1/"; client.Endpoint != expected {
+		t.Errorf("Expected endpoint %s, got %s", expected, client.Endpoint)
+	}
+}
+
+func testConfigReauth(t *testing.T, config func(mc *mock.MockController) *Config) {
+	mc := mock.NewMockController()
+	defer mc.TerminateMockControllerSafety()
+
+	keystone := fmt.Sprintf(fakeKeystonePostEriTmpl, mc.Endpoint())
+	mc.Register(t, "keystone", "/v3/auth/tokens", keystone+`
+expectedStatus:
+    - ""
+newStatus: Issued
+`)
+	mc.Register(t, "keystone", "/v3/auth/tokens", keystone+`
+expectedStatus:
+    - Issued
+newStatus: Reissued
+`)
+	mc.Register(t, "routers", "/public/v1/routers/dummy", `
+request:
+    method: GET
+response:
+    code: 401
+counter:
+    max: 0
+`)
+	mc.Register(t, "routers", "/public/v1/routers/dummy", `
+request:
+    method: GET
+response:
+    code: 200
+    body: >
+        {"router": {}}
+counter:
+    min: 1
+`)
+	mc.StartServer(t)
+
+	c := config(mc)
+	if err := c.LoadAndValidate(); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	_, err := c.OsClient.Request("GET", mc.Endpoint()+"public/v1/routers/dummy", &fic.RequestOpts{})
+	if err != nil {
+		t.Fatalf("Expected the request to succeed after re-authentication, got: %s", err)
+	}
+
+	if status := mc.Trackers["keystone"].Status; status != "Reissued" {
+		t.Errorf("Expected a new token to be issued, got status %q", status)
+	}
+}
+
+func TestConfigReauth_password(t *testing.T) {
+	testConfigReauth(t, func(mc *mock.MockController) *Config {
+		return &Config{
+			IdentityEndpoint: mc.Endpoint() + "v3/",
+			Username:         "ThisIsADummyTenantUsername",
+			Password:         "ThisIsADummyPassword",
+			TenantID:         "01234567890123456789abcdefabcdef",
+			DomainID:         "default",
+		}
+	})
+}
+
+func TestConfigReauth_tokenWithFallbackPassword(t *testing.T) {
+	testConfigReauth(t, func(mc *mock.MockController) *Config {
+		return &Config{
+			IdentityEndpoint: mc.Endpoint() + "v3/",
+			Token:            mock.FakeTokenID,
+			Username:         "ThisIsADummyTenantUsername",
+			Password:         "ThisIsADummyPassword",
+			TenantID:         "01234567890123456789abcdefabcdef",
+			DomainID:         "default",
+		}
+	})
+}
+
+func TestReauthOptions(t *testing.T) {
+	authInfo := &clientconfig.AuthInfo{
+		AuthURL:   "https://example.com:5000/v3",
+		Token:     "abcdef",
+		Username:  "jdoe",
+		Password:  "password",
+		ProjectID: "01234567890123456789abcdefabcdef",
+		DomainID:  "default",
+	}
+	clientOpts := &clientconfig.ClientOpts{AuthInfo: authInfo}
+
+	ao, err := clientconfig.AuthOptions(clientOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ao.TokenID != "abcdef" || ao.Password != "" {
+		t.Fatalf("Expected the token to take precedence, got %#v", ao)
+	}
+
+	fallback := reauthOptions(clientOpts, ao)
+	if fallback == nil {
+		t.Fatal("Expected fallback options")
+	}
+
+	if fallback.TokenID != "" || fallback.Username != "jdoe" || fallback.Password != "password" {
+		t.Errorf("Expected the fallback to use the password, got %#v", fallback)
+	}
+
+	if authInfo.Token != "abcdef" {
+		t.Error("Expected the given AuthInfo to be left unchanged")
+	}
+
+	authInfo.Password = ""
+	if fallback := reauthOptions(clientOpts, ao); fallback != nil {
+		t.Errorf("Expected no fallback without a password, got %#v", fallback)
+	}
+}
@@ -0,0 +1,224 @@
+package fic
+
+import (
+	"context"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nttcom/go-fic/fic/eri/v1/areas"
+	"github.com/nttcom/go-fic/fic/eri/v1/switches"
+)
+
+func dataSourceEriAreasV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceEriAreasV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"areas": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"number": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"is_public": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"interconnects": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"cloud": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"locations": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"switches": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"id": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"name": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"port_types": {
+													Type:     schema.TypeList,
+													Computed: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceEriAreasV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	client, err := config.eriV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	pages, err := areas.List(client, nil).AllPages()
+	if err != nil {
+		return diag.Errorf("unable to retrieve areas: %s", err)
+	}
+
+	as, err := areas.ExtractAreas(pages)
+	if err != nil {
+		return diag.Errorf("unable to extract areas: %s", err)
+	}
+
+	pages, err = switches.List(client, nil).AllPages()
+	if err != nil {
+		return diag.Errorf("unable to retrieve switches: %s", err)
+	}
+
+	sws, err := switches.ExtractSwitches(pages)
+	if err != nil {
+		return diag.Errorf("unable to extract switches: %s", err)
+	}
+
+	var names []string
+	var result []map[string]interface{}
+	for _, a := range as {
+		// GetOk can not tell an explicit false from an unset argument.
+		if v, ok := d.GetOkExists("enabled"); ok && v.(bool) != a.Enabled {
+			continue
+		}
+
+		names = append(names, a.Name)
+		result = append(result, map[string]interface{}{
+			"id":            a.ID,
+			"name":          a.Name,
+			"number":        a.Number,
+			"is_public":     a.IsPublic,
+			"enabled":       a.Enabled,
+			"interconnects": getAreaInterconnectsForState(a.Name),
+			"locations":     getAreaLocationsForState(a.Name, sws),
+		})
+	}
+
+	log.Printf("[DEBUG] Retrieved %d Eri Areas: %v", len(names), names)
+
+	d.SetId(strconv.Itoa(schema.HashString(strings.Join(names, ","))))
+	d.Set("names", names)
+	d.Set("areas", result)
+
+	return nil
+}
+
+// getAreaInterconnectsForState returns the cloud interconnect points of an
+// area which the connection resources accept, GCP points first.
+func getAreaInterconnectsForState(area string) []map[string]interface{} {
+	var result []map[string]interface{}
+	for _, ic := range gcpInterconnectsByArea[area] {
+		result = append(result, map[string]interface{}{
+			"name":  ic,
+			"cloud": "GCP",
+		})
+	}
+	for _, ic := range unoInterconnectsByArea[area] {
+		result = append(result, map[string]interface{}{
+			"name":  ic,
+			"cloud": "UNO",
+		})
+	}
+	return result
+}
+
+// getAreaLocationsForState groups the switches of an area by location.
+// Locations are sorted by name, switches keep the order of the API response.
+func getAreaLocationsForState(area string, sws []switches.Switch) []map[string]interface{} {
+	var locations []string
+	switchesByLocation := map[string][]map[string]interface{}{}
+	for _, sw := range sws {
+		if area != sw.Area {
+			continue
+		}
+
+		var portTypes []string
+		for _, pt := range sw.PortTypes {
+			if pt.Available {
+				portTypes = append(portTypes, pt.Type)
+			}
+		}
+
+		if _, ok := switchesByLocation[sw.Location]; !ok {
+			locations = append(locations, sw.Location)
+		}
+		switchesByLocation[sw.Location] = append(switchesByLocation[sw.Location], map[string]interface{}{
+			"id":         sw.ID,
+			"name":       sw.SwitchName,
+			"port_types": portTypes,
+		})
+	}
+	sort.Strings(locations)
+
+	var result []map[string]interface{}
+	for _, l := range locations {
+		result = append(result, map[string]interface{}{
+			"name":     l,
+			"switches": switchesByLocation[l],
+		})
+	}
+	return result
+}
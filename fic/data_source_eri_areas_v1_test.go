@@ -0,0 +1,108 @@
+package fic
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/nttcom/go-fic/fic/eri/v1/switches"
+)
+
+func TestAccEriV1AreasDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEriV1AreasDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.fic_eri_areas_v1.areas", "names.0"),
+					resource.TestCheckResourceAttr(
+						"data.fic_eri_areas_v1.areas", "areas.0.enabled", "true"),
+					resource.TestCheckResourceAttrSet(
+						"data.fic_eri_areas_v1.areas", "areas.0.locations.0.name"),
+					resource.TestCheckResourceAttrSet(
+						"data.fic_eri_areas_v1.areas", "areas.0.locations.0.switches.0.name"),
+				),
+			},
+		},
+	})
+}
+
+func TestGetAreaLocationsForState(t *testing.T) {
+	sws := []switches.Switch{
+		{
+			ID:         "1",
+			SwitchName: "lxea02comnw1",
+			Area:       "JPEAST",
+			Location:   "NTTComTokyo(NW2)",
+			PortTypes: []switches.PortType{
+				{Type: "1G", Available: true},
+				{Type: "10G", Available: false},
+			},
+		},
+		{
+			ID:         "2",
+			SwitchName: "lxwe01comnw1",
+			Area:       "JPWEST",
+			Location:   "NTTComOsaka(NW1)",
+		},
+		{
+			ID:         "3",
+			SwitchName: "lxea01comnw1",
+			Area:       "JPEAST",
+			Location:   "NTTComTokyo(NW1)",
+			PortTypes: []switches.PortType{
+				{Type: "10G", Available: true},
+			},
+		},
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"name": "NTTComTokyo(NW1)",
+			"switches": []map[string]interface{}{
+				{"id": "3", "name": "lxea01comnw1", "port_types": []string{"10G"}},
+			},
+		},
+		{
+			"name": "NTTComTokyo(NW2)",
+			"switches": []map[string]interface{}{
+				{"id": "1", "name": "lxea02comnw1", "port_types": []string{"1G"}},
+			},
+		},
+	}
+
+	actual := getAreaLocationsForState("JPEAST", sws)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("expected %#v, got %#v", expected, actual)
+	}
+}
+
+func TestGetAreaInterconnectsForState(t *testing.T) {
+	actual := getAreaInterconnectsForState("JPWEST")
+	if len(actual) != len(gcpInterconnectsByArea["JPWEST"])+len(unoInterconnectsByArea["JPWEST"]) {
+		t.Fatalf("unexpected interconnects %#v", actual)
+	}
+
+	expected := map[string]interface{}{"name": "Equinix-OS1-1", "cloud": "GCP"}
+	if !reflect.DeepEqual(expected, actual[0]) {
+		t.Fatalf("expected %#v, got %#v", expected, actual[0])
+	}
+
+	expected = map[string]interface{}{"name": "Interconnect-Osaka-5", "cloud": "UNO"}
+	if !reflect.DeepEqual(expected, actual[len(actual)-1]) {
+		t.Fatalf("expected %#v, got %#v", expected, actual[len(actual)-1])
+	}
+
+	if actual := getAreaInterconnectsForState("UNKNOWN"); actual != nil {
+		t.Fatalf("expected no interconnects, got %#v", actual)
+	}
+}
+
+const testAccEriV1AreasDataSourceBasic = `
+data "fic_eri_areas_v1" "areas" {
+	enabled = true
+}
+`
@@ -0,0 +1,83 @@
+package fic
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nttcom/go-fic/fic/eri/v1/ports"
+)
+
+func dataSourceEriAvailableVLANsV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceEriAvailableVLANsV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"port_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"number_of_vlans": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"vlans": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+		},
+	}
+}
+
+func dataSourceEriAvailableVLANsV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	client, err := config.eriV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	portID := d.Get("port_id").(string)
+	p, err := ports.Get(client, portID).Extract()
+	if err != nil {
+		return attributeError("port_id", fmt.Sprintf("unable to retrieve port %s", portID), err)
+	}
+
+	vlans, err := getLowestUnusedVLANs(p, d.Get("number_of_vlans").(int))
+	if err != nil {
+		return attributeError("number_of_vlans", "unable to pick unused VLANs", err)
+	}
+
+	log.Printf("[DEBUG] Available VLANs of port %s: %v", portID, vlans)
+
+	d.SetId(portID)
+	d.Set("vlans", vlans)
+
+	return nil
+}
+
+// getLowestUnusedVLANs returns the lowest count VLAN IDs of the port
+// which are not used by any connection, in ascending order.
+func getLowestUnusedVLANs(p *ports.Port, count int) ([]int, error) {
+	var unused []int
+	for _, v := range p.VLANs {
+		if v.Status == "unused" {
+			unused = append(unused, v.VID)
+		}
+	}
+	sort.Ints(unused)
+
+	if len(unused) < count {
+		return nil, fmt.Errorf("port %s has %d unused VLANs, but %d are requested", p.ID, len(unused), count)
+	}
+
+	return unused[:count], nil
+}
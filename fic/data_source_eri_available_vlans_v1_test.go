@@ -0,0 +1,82 @@
+package fic
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/nttcom/go-fic/fic/eri/v1/ports"
+)
+
+func TestAccEriV1AvailableVLANsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEriV1AvailableVLANsDataSourcePort,
+			},
+			{
+				Config: testAccEriV1AvailableVLANsDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.fic_eri_available_vlans_v1.vlans", "vlans.#", "2"),
+					resource.TestCheckResourceAttrPair(
+						"data.fic_eri_available_vlans_v1.vlans", "vlans.0",
+						"fic_eri_port_v1.port_1", "vlans.0.vid"),
+					resource.TestCheckResourceAttrPair(
+						"data.fic_eri_available_vlans_v1.vlans", "vlans.1",
+						"fic_eri_port_v1.port_1", "vlans.1.vid"),
+				),
+			},
+		},
+	})
+}
+
+func TestGetLowestUnusedVLANs(t *testing.T) {
+	p := &ports.Port{
+		ID: "F010123456789",
+		VLANs: []ports.VLAN{
+			{VID: 1026, Status: "unused"},
+			{VID: 1025, Status: "used"},
+			{VID: 1028, Status: "unused"},
+			{VID: 1027, Status: "unused"},
+		},
+	}
+
+	actual, err := getLowestUnusedVLANs(p, 2)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if expected := []int{1026, 1027}; !reflect.DeepEqual(expected, actual) {
+		t.Errorf("Expected %v, got %v", expected, actual)
+	}
+
+	if _, err := getLowestUnusedVLANs(p, 4); err == nil {
+		t.Error("Expected an error when fewer VLANs than requested are unused")
+	}
+}
+
+var testAccEriV1AvailableVLANsDataSourcePort = fmt.Sprintf(`
+resource "fic_eri_port_v1" "port_1" {
+	name = "terraform_port_1"
+	switch_name = "%s"
+	port_type = "1G"
+	number_of_vlans = 16
+}
+`,
+	OS_SWITCH_NAME,
+)
+
+var testAccEriV1AvailableVLANsDataSourceBasic = fmt.Sprintf(`
+%s
+
+data "fic_eri_available_vlans_v1" "vlans" {
+	port_id = "${fic_eri_port_v1.port_1.id}"
+	number_of_vlans = 2
+}
+`,
+	testAccEriV1AvailableVLANsDataSourcePort,
+)
@@ -0,0 +1,149 @@
+package fic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nttcom/go-fic"
+	"github.com/nttcom/go-fic/pagination"
+)
+
+// connectionLister lists the connections of one connection type.
+// The returned pager and extract function must come from the same
+// go-fic package, e.g. connections.List and connections.ExtractConnectionsInto.
+type connectionLister struct {
+	list        func(client *fic.ServiceClient) pagination.Pager
+	extractInto func(r pagination.Page, v interface{}) error
+}
+
+// dataSourceEriConnectionV1 builds a read-only data source from a connection
+// resource. Every attribute of the resource becomes computed, and the
+// connection is looked up by connection_id or name before the resource Read
+// function is called, so both share the same flattening.
+func dataSourceEriConnectionV1(r *schema.Resource, lister connectionLister) *schema.Resource {
+	s := dataSourceSchemaFromResourceSchema(r.Schema)
+
+	s["connection_id"] = &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		Computed:      true,
+		ConflictsWith: []string{"name"},
+	}
+
+	s["name"].Optional = true
+
+	// Keep the secrets of the looked up connection out of the state
+	// on request, the same as the resource does.
+	if _, ok := s["write_only_secrets"]; ok {
+		s["write_only_secrets"] = &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		}
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceEriConnectionV1Read(r.ReadContext, lister),
+		Schema:      s,
+	}
+}
+
+func dataSourceEriConnectionV1Read(read schema.ReadContextFunc, lister connectionLister) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		config := meta.(*Config)
+		client, err := config.eriV1Client(GetRegion(d, config))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		id := d.Get("connection_id").(string)
+		if id == "" {
+			name := d.Get("name").(string)
+			if name == "" {
+				return diag.Errorf("one of connection_id or name must be specified")
+			}
+
+			pages, err := lister.list(client).AllPages()
+			if err != nil {
+				return diag.Errorf("unable to retrieve connections: %s", err)
+			}
+
+			var cs []struct {
+				ID   string `json:"id"`
+				Name string `json:"name"`
+			}
+			if err := lister.extractInto(pages, &cs); err != nil {
+				return diag.Errorf("unable to extract connections: %s", err)
+			}
+
+			var ids []string
+			for _, c := range cs {
+				if name == c.Name {
+					ids = append(ids, c.ID)
+				}
+			}
+
+			if len(ids) == 0 {
+				return diag.Errorf("your query returned no results. Please change your search criteria and try again")
+			}
+
+			if len(ids) >= 2 {
+				return diag.Errorf("your query returned more than one result (%v). Please try a more specific search criteria", ids)
+			}
+
+			id = ids[0]
+		}
+
+		log.Printf("[DEBUG] Looking up Eri Connection %s", id)
+		d.SetId(id)
+
+		diags := read(ctx, d, meta)
+		if diags.HasError() {
+			return diags
+		}
+
+		// The resource Read function clears the ID when the connection is gone.
+		if d.Id() == "" {
+			return attributeError("connection_id", fmt.Sprintf("connection %s was not found", id), errors.New("no connection of this type has the ID"))
+		}
+
+		d.Set("connection_id", id)
+
+		return diags
+	}
+}
+
+// dataSourceSchemaFromResourceSchema returns a copy of a resource schema in
+// which every attribute is computed, so that it can be used by a data source.
+func dataSourceSchemaFromResourceSchema(rs map[string]*schema.Schema) map[string]*schema.Schema {
+	ds := make(map[string]*schema.Schema, len(rs))
+	for k, v := range rs {
+		ds[k] = computedSchema(v)
+	}
+	return ds
+}
+
+func computedSchema(s *schema.Schema) *schema.Schema {
+	c := &schema.Schema{
+		Type:        s.Type,
+		Computed:    true,
+		Sensitive:   s.Sensitive,
+		Description: s.Description,
+		Set:         s.Set,
+	}
+
+	switch elem := s.Elem.(type) {
+	case *schema.Resource:
+		c.Elem = &schema.Resource{
+			Schema: dataSourceSchemaFromResourceSchema(elem.Schema),
+		}
+	case *schema.Schema:
+		c.Elem = &schema.Schema{Type: elem.Type}
+	}
+
+	return c
+}
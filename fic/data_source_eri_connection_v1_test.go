@@ -0,0 +1,242 @@
+package fic
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nttcom/terraform-provider-fic/fic/testhelper/mock"
+)
+
+const testMockEriV1PortToPortConnectionsList = `
+request:
+  method: GET
+response:
+  code: 200
+  body: >
+    {
+      "connections": [
+        {"id": "F030000000000001", "name": "conn-1"},
+        {"id": "F030000000000002", "name": "conn-dup"},
+        {"id": "F030000000000003", "name": "conn-dup"}
+      ]
+    }
+`
+
+const testMockEriV1PortToPortConnectionGet = `
+request:
+  method: GET
+response:
+  code: 200
+  body: >
+    {
+      "connection": {
+        "id": "F030000000000001",
+        "name": "conn-1",
+        "redundant": false,
+        "tenantId": "87e89b8f075a4ee1aa209f6ca6ce242c",
+        "area": "JPEAST",
+        "operationId": "cc43d0f05df24b1aabdea46456d46e39",
+        "operationStatus": "Completed",
+        "source": {"portId": "F010123456789", "vlan": 101},
+        "destination": {"portId": "F019876543210", "vlan": 102},
+        "bandwidth": "100M"
+      }
+    }
+`
+
+const testMockEriV1PortToPortConnectionNotFound = `
+request:
+  method: GET
+response:
+  code: 404
+`
+
+func testEriConnectionV1DataSourceRead(t *testing.T, raw map[string]interface{}) (*schema.ResourceData, diag.Diagnostics) {
+	mc, config := testConfigWithMockKeystone(t, func(mc *mock.MockController) {
+		mc.Register(t, "connections", "/public/v1/port-to-port-connections", testMockEriV1PortToPortConnectionsList)
+		mc.Register(t, "connection", "/public/v1/port-to-port-connections/F030000000000001", testMockEriV1PortToPortConnectionGet)
+		mc.Register(t, "missing", "/public/v1/port-to-port-connections/F030000000000009", testMockEriV1PortToPortConnectionNotFound)
+	})
+	defer mc.TerminateMockControllerSafety()
+
+	if err := config.LoadAndValidate(); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	ds := dataSourceEriPortToPortConnectionV1()
+	d := schema.TestResourceDataRaw(t, ds.Schema, raw)
+
+	return d, ds.ReadContext(context.Background(), d, config)
+}
+
+func TestEriConnectionV1DataSource_byName(t *testing.T) {
+	d, diags := testEriConnectionV1DataSourceRead(t, map[string]interface{}{
+		"name": "conn-1",
+	})
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	if d.Id() != "F030000000000001" {
+		t.Errorf("Expected ID F030000000000001, got %s", d.Id())
+	}
+
+	if v := d.Get("connection_id").(string); v != "F030000000000001" {
+		t.Errorf("Expected connection_id F030000000000001, got %s", v)
+	}
+
+	if v := d.Get("source_vlan").(int); v != 101 {
+		t.Errorf("Expected source_vlan 101, got %d", v)
+	}
+
+	if v := d.Get("bandwidth").(string); v != "100M" {
+		t.Errorf("Expected bandwidth 100M, got %s", v)
+	}
+
+	if v := d.Get("operation_id").(string); v != "cc43d0f05df24b1aabdea46456d46e39" {
+		t.Errorf("Expected operation_id cc43d0f05df24b1aabdea46456d46e39, got %s", v)
+	}
+
+	if v := d.Get("operation_status").(string); v != "Completed" {
+		t.Errorf("Expected operation_status Completed, got %s", v)
+	}
+}
+
+func TestEriConnectionV1DataSource_byID(t *testing.T) {
+	d, diags := testEriConnectionV1DataSourceRead(t, map[string]interface{}{
+		"connection_id": "F030000000000001",
+	})
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	if v := d.Get("name").(string); v != "conn-1" {
+		t.Errorf("Expected name conn-1, got %s", v)
+	}
+}
+
+func TestEriConnectionV1DataSource_schema(t *testing.T) {
+	for name, ds := range map[string]*schema.Resource{
+		"port_to_port":          dataSourceEriPortToPortConnectionV1(),
+		"router_single_to_port": dataSourceEriRouterSingleToPortConnectionV1(),
+		"router_paired_to_port": dataSourceEriRouterPairedToPortConnectionV1(),
+		"router_to_ecl":         dataSourceEriRouterToECLConnectionV1(),
+		"router_to_uno":         dataSourceEriRouterToUNOConnectionV1(),
+	} {
+		for _, k := range []string{"operation_id", "operation_status"} {
+			if _, ok := ds.Schema[k]; !ok {
+				t.Errorf("%s: expected %s to be exported", name, k)
+			}
+		}
+	}
+
+	if _, ok := dataSourceEriRouterToUNOConnectionV1().Schema["destination_c_number"]; ok {
+		t.Errorf("Expected destination_c_number not to be exported, since the API does not return it")
+	}
+
+	for _, k := range []string{"destination_ecl_api_key", "destination_ecl_api_secret_key", "write_only_secrets"} {
+		if _, ok := dataSourceEriRouterToECLConnectionV1().Schema[k]; ok {
+			t.Errorf("Expected %s not to be exported, since the API does not return the credentials", k)
+		}
+	}
+}
+
+const testMockEriV1RouterToECLConnectionGet = `
+request:
+  method: GET
+response:
+  code: 200
+  body: >
+    {
+      "connection": {
+        "id": "F030000000000001",
+        "name": "conn-1",
+        "redundant": true,
+        "tenantId": "87e89b8f075a4ee1aa209f6ca6ce242c",
+        "area": "JPEAST",
+        "operationId": "cc43d0f05df24b1aabdea46456d46e39",
+        "operationStatus": "Completed",
+        "source": {
+          "routerId": "F022000000000001",
+          "groupName": "group_1",
+          "routeFilter": {"in": "fullRoute", "out": "fullRoute"}
+        },
+        "destination": {
+          "interconnect": "ECL-TYO-GU-Z1-01",
+          "qosType": "guarantee",
+          "eclTenantId": "f4d4a4fe7b9c4c7b8a4e8c1d3f3b2a10"
+        },
+        "bandwidth": "10M"
+      }
+    }
+`
+
+func TestEriConnectionV1DataSource_routerToECL(t *testing.T) {
+	mc, config := testConfigWithMockKeystone(t, func(mc *mock.MockController) {
+		mc.Register(t, "connection", "/public/v1/router-to-ecl-connections/F030000000000001", testMockEriV1RouterToECLConnectionGet)
+	})
+	defer mc.TerminateMockControllerSafety()
+
+	if err := config.LoadAndValidate(); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	ds := dataSourceEriRouterToECLConnectionV1()
+	d := schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{
+		"connection_id": "F030000000000001",
+	})
+
+	if diags := ds.ReadContext(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	if v := d.Get("bandwidth").(string); v != "10M" {
+		t.Errorf("Expected bandwidth 10M, got %s", v)
+	}
+}
+
+func TestEriConnectionV1DataSource_errors(t *testing.T) {
+	cases := []struct {
+		raw      map[string]interface{}
+		expected string
+	}{
+		{map[string]interface{}{"name": "conn-dup"}, "more than one result"},
+		{map[string]interface{}{"name": "conn-none"}, "no results"},
+		{map[string]interface{}{"connection_id": "F030000000000009"}, "was not found"},
+		{map[string]interface{}{}, "one of connection_id or name must be specified"},
+	}
+
+	for _, c := range cases {
+		_, diags := testEriConnectionV1DataSourceRead(t, c.raw)
+		if !diags.HasError() || !strings.Contains(diags[0].Summary, c.expected) {
+			t.Errorf("%v: expected an error containing %q, got %v", c.raw, c.expected, diags)
+		}
+	}
+}
+
+func TestDataSourceSchemaFromResourceSchema(t *testing.T) {
+	s := dataSourceSchemaFromResourceSchema(resourceEriRouterToAzurePrivateConnectionV1().Schema)
+
+	for k, v := range s {
+		if !v.Computed || v.Optional || v.Required || v.ForceNew {
+			t.Errorf("Expected %s to be computed only, got %#v", k, v)
+		}
+	}
+
+	if !s["destination_service_key"].Sensitive {
+		t.Error("Expected destination_service_key to stay sensitive")
+	}
+}
+
+func TestEriConnectionV1DataSource_notFoundAttributePath(t *testing.T) {
+	_, diags := testEriConnectionV1DataSourceRead(t, map[string]interface{}{
+		"connection_id": "F030000000000009",
+	})
+	if !diags.HasError() || !diags[0].AttributePath.Equals(cty.GetAttrPath("connection_id")) {
+		t.Errorf("Expected the error to point at connection_id, got %v", diags)
+	}
+}
@@ -0,0 +1,191 @@
+package fic
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nttcom/go-fic/fic/eri/v1/routers/components/firewalls"
+)
+
+func dataSourceEriFirewallComponentV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceEriFirewallComponentV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"router_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"firewall_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"user_ip_addresses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"rules": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"from": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"to": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"entries": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"match_source_address_sets": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"match_destination_address_sets": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"match_application": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"action": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"custom_applications": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"protocol": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"destination_port": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"application_sets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"applications": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+
+			"routing_group_settings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"address_sets": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"addresses": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"redundant": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"is_activated": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceEriFirewallComponentV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	client, err := config.eriV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	routerID := d.Get("router_id").(string)
+	firewallID := d.Get("firewall_id").(string)
+
+	r, err := firewalls.Get(client, routerID, firewallID).Extract()
+	if err != nil {
+		return diag.Errorf("unable to retrieve firewall component %s of router %s: %s", firewallID, routerID, err)
+	}
+
+	log.Printf("[DEBUG] Retrieved Eri Firewall Component %s: %+v", firewallID, r)
+	d.SetId(fmt.Sprintf("%s/%s", routerID, firewallID))
+
+	d.Set("user_ip_addresses", r.UserIPAddresses)
+	d.Set("redundant", r.Redundant)
+	d.Set("is_activated", r.IsActivated)
+
+	d.Set("rules", getRulesForState(r))
+	d.Set("custom_applications", getCustomApplicationsForState(r))
+	d.Set("application_sets", getApplicationSetsForState(r))
+	d.Set("routing_group_settings", getRoutingGroupSettingsForState(r))
+
+	return nil
+}
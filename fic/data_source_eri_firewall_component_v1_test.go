@@ -0,0 +1,52 @@
+package fic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccEriV1FirewallComponentDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckArea(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigEriFirewallComponentV1Update,
+			},
+			{
+				Config: testAccEriV1FirewallComponentDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.fic_eri_firewall_component_v1.firewall_1", "id",
+						"fic_eri_firewall_component_v1.firewall_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.fic_eri_firewall_component_v1.firewall_1", "is_activated", "true"),
+					resource.TestCheckResourceAttr(
+						"data.fic_eri_firewall_component_v1.firewall_1", "rules.0.entries.0.name", "rule-01"),
+					resource.TestCheckResourceAttr(
+						"data.fic_eri_firewall_component_v1.firewall_1", "custom_applications.0.name", "google-drive-web"),
+					resource.TestCheckResourceAttr(
+						"data.fic_eri_firewall_component_v1.firewall_1", "application_sets.0.name", "app_set_1"),
+					resource.TestCheckResourceAttr(
+						"data.fic_eri_firewall_component_v1.firewall_1", "routing_group_settings.0.address_sets.0.name", "group1_addset_1"),
+				),
+			},
+		},
+	})
+}
+
+var testAccEriV1FirewallComponentDataSourceBasic = fmt.Sprintf(`
+%s
+
+data "fic_eri_firewall_component_v1" "firewall_1" {
+	router_id = "${fic_eri_firewall_component_v1.firewall_1.router_id}"
+	firewall_id = "${fic_eri_firewall_component_v1.firewall_1.firewall_id}"
+}
+`,
+	testAccConfigEriFirewallComponentV1Update,
+)
@@ -0,0 +1,182 @@
+package fic
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nttcom/go-fic/fic/eri/v1/routers/components/nats"
+)
+
+func dataSourceEriNATComponentV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceEriNATComponentV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"router_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"nat_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"user_ip_addresses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"global_ip_address_sets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"number_of_addresses": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"addresses": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+
+			"source_napt_rules": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"from": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"to": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"entries": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"then": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"destination_nat_rules": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"from": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"to": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"entries": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"match_destination_address": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"then": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"redundant": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"is_activated": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceEriNATComponentV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	client, err := config.eriV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	routerID := d.Get("router_id").(string)
+	natID := d.Get("nat_id").(string)
+
+	r, err := nats.Get(client, routerID, natID).Extract()
+	if err != nil {
+		return diag.Errorf("unable to retrieve nat component %s of router %s: %s", natID, routerID, err)
+	}
+
+	log.Printf("[DEBUG] Retrieved Eri NAT Component %s: %+v", natID, r)
+	d.SetId(fmt.Sprintf("%s/%s", routerID, natID))
+
+	d.Set("user_ip_addresses", r.UserIPAddresses)
+	d.Set("global_ip_address_sets", getNATGlobalIPAddressSetsForState(r))
+	d.Set("source_napt_rules", getSourceNAPTRuleForState(r))
+	d.Set("destination_nat_rules", getDestinationNATRuleForState(r))
+	d.Set("redundant", r.Redundant)
+	d.Set("is_activated", r.IsActivated)
+
+	return nil
+}
+
+func getNATGlobalIPAddressSetsForState(r *nats.NAT) []map[string]interface{} {
+	var result []map[string]interface{}
+	for _, g := range r.GlobalIPAddressSets {
+		m := map[string]interface{}{
+			"id":                  g.ID,
+			"name":                g.Name,
+			"type":                g.Type,
+			"number_of_addresses": g.NumberOfAddresses,
+			"addresses":           g.Addresses,
+		}
+		result = append(result, m)
+	}
+	return result
+}
@@ -0,0 +1,50 @@
+package fic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccEriV1NATComponentDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckArea(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigEriNATComponentV1Basic,
+			},
+			{
+				Config: testAccEriV1NATComponentDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.fic_eri_nat_component_v1.nat_1", "id",
+						"fic_eri_nat_component_v1.nat_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.fic_eri_nat_component_v1.nat_1", "is_activated", "true"),
+					resource.TestCheckResourceAttr(
+						"data.fic_eri_nat_component_v1.nat_1", "user_ip_addresses.#", "8"),
+					resource.TestCheckResourceAttr(
+						"data.fic_eri_nat_component_v1.nat_1", "global_ip_address_sets.#", "2"),
+					resource.TestCheckResourceAttrSet(
+						"data.fic_eri_nat_component_v1.nat_1", "global_ip_address_sets.0.addresses.0"),
+				),
+			},
+		},
+	})
+}
+
+var testAccEriV1NATComponentDataSourceBasic = fmt.Sprintf(`
+%s
+
+data "fic_eri_nat_component_v1" "nat_1" {
+	router_id = "${fic_eri_nat_component_v1.nat_1.router_id}"
+	nat_id = "${fic_eri_nat_component_v1.nat_1.nat_id}"
+}
+`,
+	testAccConfigEriNATComponentV1Basic,
+)
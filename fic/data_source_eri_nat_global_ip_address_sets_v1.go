@@ -0,0 +1,126 @@
+package fic
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nttcom/go-fic/fic/eri/v1/routers/components/nat_global_ip_address_sets"
+)
+
+func dataSourceEriNATGlobalIPAddressSetsV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceEriNATGlobalIPAddressSetsV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"router_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"nat_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"sourceNapt", "destinationNat",
+				}, false),
+			},
+
+			"addresses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"global_ip_address_sets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"number_of_addresses": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"addresses": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"operation_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceEriNATGlobalIPAddressSetsV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	client, err := config.eriV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	routerID := d.Get("router_id").(string)
+	natID := d.Get("nat_id").(string)
+	gipType := d.Get("type").(string)
+
+	pages, err := nat_global_ip_address_sets.List(client, routerID, natID, nil).AllPages()
+	if err != nil {
+		return diag.Errorf("unable to retrieve global ip address sets of nat %s: %s", natID, err)
+	}
+
+	gips, err := nat_global_ip_address_sets.ExtractGlobalIPAddressSets(pages)
+	if err != nil {
+		return diag.Errorf("unable to extract global ip address sets of nat %s: %s", natID, err)
+	}
+
+	addresses := []string{}
+	var result []map[string]interface{}
+	for _, g := range gips {
+		if gipType != "" && gipType != g.Type {
+			continue
+		}
+
+		addresses = append(addresses, g.Addresses...)
+		result = append(result, map[string]interface{}{
+			"id":                  g.ID,
+			"name":                g.Name,
+			"type":                g.Type,
+			"number_of_addresses": g.NumberOfAddresses,
+			"addresses":           g.Addresses,
+			"operation_status":    g.OperationStatus,
+		})
+	}
+
+	log.Printf("[DEBUG] Retrieved %d global ip address sets of nat %s", len(result), natID)
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", routerID, natID, gipType))
+	d.Set("addresses", addresses)
+	d.Set("global_ip_address_sets", result)
+
+	return nil
+}
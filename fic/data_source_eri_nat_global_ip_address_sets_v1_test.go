@@ -0,0 +1,55 @@
+package fic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccEriV1NATGlobalIPAddressSetsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckArea(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigEriNATGlobalIPAddressSetV1Basic,
+			},
+			{
+				Config: testAccEriV1NATGlobalIPAddressSetsDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.fic_eri_nat_global_ip_address_sets_v1.all", "global_ip_address_sets.#", "3"),
+					resource.TestCheckResourceAttr(
+						"data.fic_eri_nat_global_ip_address_sets_v1.all", "addresses.#", "11"),
+					resource.TestCheckResourceAttr(
+						"data.fic_eri_nat_global_ip_address_sets_v1.dnat", "global_ip_address_sets.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.fic_eri_nat_global_ip_address_sets_v1.dnat", "global_ip_address_sets.0.name", "dst-set-01"),
+					resource.TestCheckResourceAttr(
+						"data.fic_eri_nat_global_ip_address_sets_v1.dnat", "addresses.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+var testAccEriV1NATGlobalIPAddressSetsDataSourceBasic = fmt.Sprintf(`
+%s
+
+data "fic_eri_nat_global_ip_address_sets_v1" "all" {
+	router_id = "${fic_eri_nat_global_ip_address_set_v1.gip_1.router_id}"
+	nat_id = "${fic_eri_nat_global_ip_address_set_v1.gip_1.nat_id}"
+}
+
+data "fic_eri_nat_global_ip_address_sets_v1" "dnat" {
+	router_id = "${fic_eri_nat_global_ip_address_set_v1.gip_1.router_id}"
+	nat_id = "${fic_eri_nat_global_ip_address_set_v1.gip_1.nat_id}"
+	type = "destinationNat"
+}
+`,
+	testAccConfigEriNATGlobalIPAddressSetV1Basic,
+)
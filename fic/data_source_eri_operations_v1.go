@@ -0,0 +1,156 @@
+package fic
+
+import (
+	"context"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nttcom/go-fic/fic/eri/v1/operations"
+)
+
+func dataSourceEriOperationsV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceEriOperationsV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"resource_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Processing", "Completed", "Error",
+				}, false),
+			},
+
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"operations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tenant_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"request_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"reception_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"commit_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"error": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceEriOperationsV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	client, err := config.eriV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	pages, err := operations.List(client, nil).AllPages()
+	if err != nil {
+		return diag.Errorf("unable to retrieve operations: %s", err)
+	}
+
+	ops, err := operations.ExtractOperations(pages)
+	if err != nil {
+		return diag.Errorf("unable to extract operations: %s", err)
+	}
+
+	resourceID := d.Get("resource_id").(string)
+	status := d.Get("status").(string)
+
+	var matches []operations.Operation
+	for _, op := range ops {
+		if resourceID != "" && resourceID != op.ResourceID {
+			continue
+		}
+
+		if status != "" && status != op.Status {
+			continue
+		}
+
+		matches = append(matches, op)
+	}
+
+	// Reception times are in RFC 3339, so the most recent operation comes
+	// first when sorting them as strings.
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].ReceptionTime > matches[j].ReceptionTime
+	})
+
+	var ids []string
+	var result []map[string]interface{}
+	for _, op := range matches {
+		ids = append(ids, op.ID)
+		result = append(result, map[string]interface{}{
+			"id":             op.ID,
+			"tenant_id":      op.TenantID,
+			"resource_id":    op.ResourceID,
+			"resource_name":  op.ResourceName,
+			"resource_type":  op.ResourceType,
+			"request_type":   op.RequestType,
+			"status":         op.Status,
+			"reception_time": op.ReceptionTime,
+			"commit_time":    op.CommitTime,
+			"error":          op.Error,
+		})
+	}
+
+	log.Printf("[DEBUG] Retrieved %d Eri Operations: %v", len(ids), ids)
+
+	d.SetId(strconv.Itoa(schema.HashString(strings.Join(ids, ","))))
+	d.Set("ids", ids)
+	d.Set("operations", result)
+
+	return nil
+}
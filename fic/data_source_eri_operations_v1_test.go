@@ -0,0 +1,124 @@
+package fic
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nttcom/terraform-provider-fic/fic/testhelper/mock"
+)
+
+func TestAccEriV1OperationsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckArea(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEriV1RouterDataSourceRouter,
+			},
+			{
+				Config: testAccEriV1OperationsDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.fic_eri_operations_v1.operations", "operations.0.resource_id",
+						"fic_eri_router_v1.router_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.fic_eri_operations_v1.operations", "operations.0.status", "Completed"),
+					resource.TestCheckResourceAttrSet(
+						"data.fic_eri_operations_v1.operations", "ids.0"),
+				),
+			},
+		},
+	})
+}
+
+var testAccEriV1OperationsDataSourceBasic = fmt.Sprintf(`
+%s
+
+data "fic_eri_operations_v1" "operations" {
+	resource_id = "${fic_eri_router_v1.router_1.id}"
+}
+`,
+	testAccEriV1RouterDataSourceRouter,
+)
+
+const testMockEriV1OperationsList = `
+request:
+  method: GET
+response:
+  code: 200
+  body: >
+    {
+      "operations": [
+        {
+          "id": "op-1",
+          "resourceId": "F022000000000001",
+          "resourceType": "Router",
+          "requestType": "Create",
+          "status": "Completed",
+          "receptionTime": "2020-07-24T06:16:46Z"
+        },
+        {
+          "id": "op-2",
+          "resourceId": "F022000000000001",
+          "resourceType": "Router",
+          "requestType": "Update",
+          "status": "Error",
+          "receptionTime": "2020-07-25T06:16:46Z",
+          "error": "user ip address overlaps with an existing router"
+        },
+        {
+          "id": "op-3",
+          "resourceId": "F022000000000002",
+          "resourceType": "Router",
+          "requestType": "Create",
+          "status": "Completed",
+          "receptionTime": "2020-07-26T06:16:46Z"
+        }
+      ]
+    }
+`
+
+func TestEriOperationsV1DataSource(t *testing.T) {
+	mc, config := testConfigWithMockKeystone(t, func(mc *mock.MockController) {
+		mc.Register(t, "operations", "/public/v1/operations", testMockEriV1OperationsList)
+	})
+	defer mc.TerminateMockControllerSafety()
+
+	if err := config.LoadAndValidate(); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	cases := []struct {
+		raw      map[string]interface{}
+		expected []interface{}
+	}{
+		{map[string]interface{}{}, []interface{}{"op-3", "op-2", "op-1"}},
+		{map[string]interface{}{"resource_id": "F022000000000001"}, []interface{}{"op-2", "op-1"}},
+		{map[string]interface{}{"status": "Error"}, []interface{}{"op-2"}},
+	}
+
+	ds := dataSourceEriOperationsV1()
+	for _, c := range cases {
+		d := schema.TestResourceDataRaw(t, ds.Schema, c.raw)
+		if diags := ds.ReadContext(context.Background(), d, config); diags.HasError() {
+			t.Fatalf("%v: unexpected error: %v", c.raw, diags)
+		}
+
+		if actual := d.Get("ids").([]interface{}); !reflect.DeepEqual(c.expected, actual) {
+			t.Errorf("%v: expected %v, got %v", c.raw, c.expected, actual)
+		}
+	}
+
+	d := schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{"status": "Error"})
+	ds.ReadContext(context.Background(), d, config)
+	if v := d.Get("operations.0.error").(string); v != "user ip address overlaps with an existing router" {
+		t.Errorf("Expected the operation error message, got %q", v)
+	}
+}
@@ -0,0 +1,18 @@
+package fic
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nttcom/go-fic"
+	"github.com/nttcom/go-fic/pagination"
+
+	connections "github.com/nttcom/go-fic/fic/eri/v1/port_to_azure_private_connections"
+)
+
+func dataSourceEriPortToAzurePrivateConnectionV1() *schema.Resource {
+	return dataSourceEriConnectionV1(resourceEriPortToAzurePrivateConnectionV1(), connectionLister{
+		list: func(client *fic.ServiceClient) pagination.Pager {
+			return connections.List(client, nil)
+		},
+		extractInto: connections.ExtractConnectionsInto,
+	})
+}
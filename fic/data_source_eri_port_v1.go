@@ -0,0 +1,171 @@
+package fic
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nttcom/go-fic/fic/eri/v1/ports"
+)
+
+func dataSourceEriPortV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceEriPortV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"port_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"name"},
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"switch_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"port_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"is_activated": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"area": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"location": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"vlan_ranges": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"start": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"end": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"vlans": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"vid": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceEriPortV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	client, err := config.eriV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var port ports.Port
+
+	if v, ok := d.GetOk("port_id"); ok {
+		p, err := ports.Get(client, v.(string)).Extract()
+		if err != nil {
+			return diag.Errorf("unable to retrieve port %s: %s", v.(string), err)
+		}
+		port = *p
+	} else {
+		name := d.Get("name").(string)
+		if name == "" {
+			return diag.Errorf("one of port_id or name must be specified")
+		}
+
+		pages, err := ports.List(client, nil).AllPages()
+		if err != nil {
+			return diag.Errorf("unable to retrieve ports: %s", err)
+		}
+
+		ps, err := ports.ExtractPorts(pages)
+		if err != nil {
+			return diag.Errorf("unable to extract ports: %s", err)
+		}
+
+		var matches []ports.Port
+		for _, p := range ps {
+			if name == p.Name {
+				matches = append(matches, p)
+			}
+		}
+
+		if len(matches) == 0 {
+			return diag.Errorf("your query returned no results. Please change your search criteria and try again")
+		}
+
+		if len(matches) >= 2 {
+			var ids []string
+			for _, p := range matches {
+				ids = append(ids, p.ID)
+			}
+			return diag.Errorf("your query returned more than one result (%v). Please try a more specific search criteria", ids)
+		}
+
+		port = matches[0]
+	}
+
+	log.Printf("[DEBUG] Retrieved Eri Port %s: %+v", port.ID, port)
+
+	vlanRanges, err := getVLANRangesForState(&port)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(port.ID)
+
+	d.Set("port_id", port.ID)
+	d.Set("name", port.Name)
+	d.Set("switch_name", port.SwitchName)
+	d.Set("port_type", port.PortType)
+	d.Set("is_activated", port.IsActivated)
+	d.Set("tenant_id", port.TenantID)
+	d.Set("area", port.Area)
+	d.Set("location", port.Location)
+	d.Set("vlan_ranges", vlanRanges)
+	d.Set("vlans", getVLANsForState(&port))
+
+	return nil
+}
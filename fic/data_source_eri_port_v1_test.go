@@ -0,0 +1,83 @@
+package fic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccEriV1PortDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEriV1PortDataSourcePort,
+			},
+			{
+				Config: testAccEriV1PortDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.fic_eri_port_v1.port_1", "id",
+						"fic_eri_port_v1.port_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.fic_eri_port_v1.port_1", "name", "terraform_port_1"),
+					resource.TestCheckResourceAttr(
+						"data.fic_eri_port_v1.port_1", "switch_name", OS_SWITCH_NAME),
+					resource.TestCheckResourceAttr(
+						"data.fic_eri_port_v1.port_1", "port_type", "1G"),
+					resource.TestCheckResourceAttr(
+						"data.fic_eri_port_v1.port_1", "is_activated", "false"),
+					resource.TestCheckResourceAttrSet(
+						"data.fic_eri_port_v1.port_1", "vlan_ranges.0.start"),
+					resource.TestCheckResourceAttrSet(
+						"data.fic_eri_port_v1.port_1", "vlan_ranges.0.end"),
+					resource.TestCheckResourceAttr(
+						"data.fic_eri_port_v1.port_1", "vlans.#", "16"),
+				),
+			},
+			{
+				Config: testAccEriV1PortDataSourceID,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.fic_eri_port_v1.port_1", "id",
+						"fic_eri_port_v1.port_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.fic_eri_port_v1.port_1", "name", "terraform_port_1"),
+				),
+			},
+		},
+	})
+}
+
+var testAccEriV1PortDataSourcePort = fmt.Sprintf(`
+resource "fic_eri_port_v1" "port_1" {
+	name = "terraform_port_1"
+	switch_name = "%s"
+	port_type = "1G"
+	number_of_vlans = 16
+}
+`,
+	OS_SWITCH_NAME,
+)
+
+var testAccEriV1PortDataSourceBasic = fmt.Sprintf(`
+%s
+
+data "fic_eri_port_v1" "port_1" {
+	name = "${fic_eri_port_v1.port_1.name}"
+}
+`,
+	testAccEriV1PortDataSourcePort,
+)
+
+var testAccEriV1PortDataSourceID = fmt.Sprintf(`
+%s
+
+data "fic_eri_port_v1" "port_1" {
+	port_id = "${fic_eri_port_v1.port_1.id}"
+}
+`,
+	testAccEriV1PortDataSourcePort,
+)
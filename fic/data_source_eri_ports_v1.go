@@ -0,0 +1,225 @@
+package fic
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nttcom/go-fic/fic/eri/v1/ports"
+)
+
+func dataSourceEriPortsV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceEriPortsV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"switch_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"area": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"location": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"port_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"1G", "10G"}, false),
+			},
+
+			"is_activated": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"ports": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"switch_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_activated": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"tenant_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"area": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"location": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vlan_ranges": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"start": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"end": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"vlans": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"vid": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"status": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceEriPortsV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	client, err := config.eriV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	pages, err := ports.List(client, nil).AllPages()
+	if err != nil {
+		return diag.Errorf("unable to retrieve ports: %s", err)
+	}
+
+	ps, err := ports.ExtractPorts(pages)
+	if err != nil {
+		return diag.Errorf("unable to extract ports: %s", err)
+	}
+
+	opts := struct {
+		switchName  string
+		area        string
+		location    string
+		portType    string
+		isActivated *bool
+	}{}
+
+	if v, ok := d.GetOk("switch_name"); ok {
+		opts.switchName = v.(string)
+	}
+
+	if v, ok := d.GetOk("area"); ok {
+		opts.area = v.(string)
+	}
+
+	if v, ok := d.GetOk("location"); ok {
+		opts.location = v.(string)
+	}
+
+	if v, ok := d.GetOk("port_type"); ok {
+		opts.portType = v.(string)
+	}
+
+	// GetOk can not tell an explicit false from an unset argument.
+	if v, ok := d.GetOkExists("is_activated"); ok {
+		isActivated := v.(bool)
+		opts.isActivated = &isActivated
+	}
+
+	var ids []string
+	var result []map[string]interface{}
+	for _, p := range ps {
+		if opts.switchName != "" && opts.switchName != p.SwitchName {
+			continue
+		}
+
+		if opts.area != "" && opts.area != p.Area {
+			continue
+		}
+
+		if opts.location != "" && opts.location != p.Location {
+			continue
+		}
+
+		if opts.portType != "" && opts.portType != p.PortType {
+			continue
+		}
+
+		if opts.isActivated != nil && *opts.isActivated != p.IsActivated {
+			continue
+		}
+
+		vlanRanges, err := getVLANRangesForState(&p)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		ids = append(ids, p.ID)
+		result = append(result, map[string]interface{}{
+			"id":           p.ID,
+			"name":         p.Name,
+			"switch_name":  p.SwitchName,
+			"port_type":    p.PortType,
+			"is_activated": p.IsActivated,
+			"tenant_id":    p.TenantID,
+			"area":         p.Area,
+			"location":     p.Location,
+			"vlan_ranges":  vlanRanges,
+			"vlans":        getVLANsForState(&p),
+		})
+	}
+
+	log.Printf("[DEBUG] Retrieved %d Eri Ports: %v", len(ids), ids)
+
+	d.SetId(strconv.Itoa(schema.HashString(strings.Join(ids, ","))))
+	d.Set("ids", ids)
+	d.Set("ports", result)
+
+	return nil
+}
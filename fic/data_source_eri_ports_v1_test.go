@@ -0,0 +1,60 @@
+package fic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccEriV1PortsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEriV1PortsDataSourcePort,
+			},
+			{
+				Config: testAccEriV1PortsDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.fic_eri_ports_v1.ports", "ids.0"),
+					resource.TestCheckResourceAttr(
+						"data.fic_eri_ports_v1.ports", "ports.0.switch_name", OS_SWITCH_NAME),
+					resource.TestCheckResourceAttr(
+						"data.fic_eri_ports_v1.ports", "ports.0.port_type", "1G"),
+					resource.TestCheckResourceAttr(
+						"data.fic_eri_ports_v1.ports", "ports.0.is_activated", "false"),
+					resource.TestCheckResourceAttrSet(
+						"data.fic_eri_ports_v1.ports", "ports.0.vlan_ranges.0.start"),
+					resource.TestCheckResourceAttrSet(
+						"data.fic_eri_ports_v1.ports", "ports.0.vlans.0.vid"),
+				),
+			},
+		},
+	})
+}
+
+var testAccEriV1PortsDataSourcePort = fmt.Sprintf(`
+resource "fic_eri_port_v1" "port_1" {
+	name = "terraform_port_1"
+	switch_name = "%s"
+	port_type = "1G"
+	number_of_vlans = 16
+}
+`,
+	OS_SWITCH_NAME,
+)
+
+var testAccEriV1PortsDataSourceBasic = fmt.Sprintf(`
+%s
+
+data "fic_eri_ports_v1" "ports" {
+	switch_name = "${fic_eri_port_v1.port_1.switch_name}"
+	port_type = "1G"
+	is_activated = false
+}
+`,
+	testAccEriV1PortsDataSourcePort,
+)
@@ -0,0 +1,50 @@
+package fic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccEriV1RouterSingleToPortConnectionDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckSwitchName(t)
+			testAccPreCheckArea(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigEriRouterSingleToPortConnectionV1Basic,
+			},
+			{
+				Config: testAccEriV1RouterSingleToPortConnectionDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.fic_eri_router_single_to_port_connection_v1.connection_1", "id",
+						"fic_eri_router_single_to_port_connection_v1.connection_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.fic_eri_router_single_to_port_connection_v1.connection_1", "name", "terraform_connection_1"),
+					resource.TestCheckResourceAttrPair(
+						"data.fic_eri_router_single_to_port_connection_v1.connection_1", "bandwidth",
+						"fic_eri_router_single_to_port_connection_v1.connection_1", "bandwidth"),
+					resource.TestCheckResourceAttrPair(
+						"data.fic_eri_router_single_to_port_connection_v1.connection_1", "source_information.0.ip_address",
+						"fic_eri_router_single_to_port_connection_v1.connection_1", "source_information.0.ip_address"),
+				),
+			},
+		},
+	})
+}
+
+var testAccEriV1RouterSingleToPortConnectionDataSourceBasic = fmt.Sprintf(`
+%s
+
+data "fic_eri_router_single_to_port_connection_v1" "connection_1" {
+	name = "${fic_eri_router_single_to_port_connection_v1.connection_1.name}"
+}
+`,
+	testAccConfigEriRouterSingleToPortConnectionV1Basic,
+)
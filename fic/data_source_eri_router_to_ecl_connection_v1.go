@@ -0,0 +1,27 @@
+package fic
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nttcom/go-fic"
+	"github.com/nttcom/go-fic/pagination"
+
+	connections "github.com/nttcom/go-fic/fic/eri/v1/router_to_ecl_connections"
+)
+
+func dataSourceEriRouterToECLConnectionV1() *schema.Resource {
+	r := dataSourceEriConnectionV1(resourceEriRouterToECLConnectionV1(), connectionLister{
+		list: func(client *fic.ServiceClient) pagination.Pager {
+			return connections.List(client, nil)
+		},
+		extractInto: connections.ExtractConnectionsInto,
+	})
+
+	// The API does not return the ECL API credentials of a connection. The
+	// resource keeps the configured ones, which a looked up connection does
+	// not have, so there are no secrets to keep out of the state either.
+	delete(r.Schema, "destination_ecl_api_key")
+	delete(r.Schema, "destination_ecl_api_secret_key")
+	delete(r.Schema, "write_only_secrets")
+
+	return r
+}
@@ -0,0 +1,24 @@
+package fic
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nttcom/go-fic"
+	"github.com/nttcom/go-fic/pagination"
+
+	connections "github.com/nttcom/go-fic/fic/eri/v1/router_to_uno_connections"
+)
+
+func dataSourceEriRouterToUNOConnectionV1() *schema.Resource {
+	r := dataSourceEriConnectionV1(resourceEriRouterToUNOConnectionV1(), connectionLister{
+		list: func(client *fic.ServiceClient) pagination.Pager {
+			return connections.List(client, nil)
+		},
+		extractInto: connections.ExtractConnectionsInto,
+	})
+
+	// The API does not return the C number of a connection. The resource
+	// keeps the configured one, which a looked up connection does not have.
+	delete(r.Schema, "destination_c_number")
+
+	return r
+}
@@ -0,0 +1,193 @@
+package fic
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nttcom/go-fic/fic/eri/v1/routers"
+)
+
+func dataSourceEriRouterV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceEriRouterV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"router_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"name", "area"},
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"area": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"user_ip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"redundant": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"firewalls": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_activated": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"nats": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_activated": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"routing_groups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"firewall_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"nat_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceEriRouterV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	client, err := config.eriV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var router routers.Router
+
+	if v, ok := d.GetOk("router_id"); ok {
+		r, err := routers.Get(client, v.(string)).Extract()
+		if err != nil {
+			return diag.Errorf("unable to retrieve router %s: %s", v.(string), err)
+		}
+		router = *r
+	} else {
+		name := d.Get("name").(string)
+		area := d.Get("area").(string)
+		if name == "" {
+			return diag.Errorf("one of router_id or name must be specified")
+		}
+
+		pages, err := routers.List(client, nil).AllPages()
+		if err != nil {
+			return diag.Errorf("unable to retrieve routers: %s", err)
+		}
+
+		rs, err := routers.ExtractRouters(pages)
+		if err != nil {
+			return diag.Errorf("unable to extract routers: %s", err)
+		}
+
+		var matches []routers.Router
+		for _, r := range rs {
+			if name != r.Name {
+				continue
+			}
+
+			if area != "" && area != r.Area {
+				continue
+			}
+
+			matches = append(matches, r)
+		}
+
+		if len(matches) == 0 {
+			return diag.Errorf("your query returned no results. Please change your search criteria and try again")
+		}
+
+		if len(matches) >= 2 {
+			var ids []string
+			for _, r := range matches {
+				ids = append(ids, r.ID)
+			}
+			return diag.Errorf("your query returned more than one result (%v). Please try a more specific search criteria", ids)
+		}
+
+		router = matches[0]
+	}
+
+	log.Printf("[DEBUG] Retrieved Eri Router %s: %+v", router.ID, router)
+	d.SetId(router.ID)
+
+	d.Set("router_id", router.ID)
+	d.Set("name", router.Name)
+	d.Set("area", router.Area)
+	d.Set("user_ip_address", router.UserIPAddress)
+	d.Set("redundant", router.Redundant)
+	d.Set("tenant_id", router.TenantID)
+	d.Set("firewalls", getRouterFirewallForState(&router))
+	d.Set("nats", getRouterNATForState(&router))
+	d.Set("routing_groups", getRoutingGroupForState(&router))
+
+	if len(router.Firewalls) > 0 {
+		d.Set("firewall_id", router.Firewalls[0].ID)
+	}
+
+	if len(router.NATs) > 0 {
+		d.Set("nat_id", router.NATs[0].ID)
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package fic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccEriV1RouterDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckArea(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEriV1RouterDataSourceRouter,
+			},
+			{
+				Config: testAccEriV1RouterDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.fic_eri_router_v1.router_1", "id",
+						"fic_eri_router_v1.router_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.fic_eri_router_v1.router_1", "name", "terraform_router_1"),
+					resource.TestCheckResourceAttr(
+						"data.fic_eri_router_v1.router_1", "area", OS_AREA_NAME),
+					resource.TestCheckResourceAttr(
+						"data.fic_eri_router_v1.router_1", "user_ip_address", "10.0.0.0/27"),
+					resource.TestCheckResourceAttr(
+						"data.fic_eri_router_v1.router_1", "redundant", "true"),
+					resource.TestCheckResourceAttrPair(
+						"data.fic_eri_router_v1.router_1", "firewall_id",
+						"fic_eri_router_v1.router_1", "firewall_id"),
+					resource.TestCheckResourceAttrPair(
+						"data.fic_eri_router_v1.router_1", "nat_id",
+						"fic_eri_router_v1.router_1", "nat_id"),
+					resource.TestCheckResourceAttrSet(
+						"data.fic_eri_router_v1.router_1", "routing_groups.0.name"),
+				),
+			},
+			{
+				Config: testAccEriV1RouterDataSourceID,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.fic_eri_router_v1.router_1", "id",
+						"fic_eri_router_v1.router_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.fic_eri_router_v1.router_1", "name", "terraform_router_1"),
+				),
+			},
+		},
+	})
+}
+
+var testAccEriV1RouterDataSourceRouter = fmt.Sprintf(`
+resource "fic_eri_router_v1" "router_1" {
+	name = "terraform_router_1"
+	area = "%s"
+	user_ip_address = "10.0.0.0/27"
+	redundant = true
+}
+`,
+	OS_AREA_NAME,
+)
+
+var testAccEriV1RouterDataSourceBasic = fmt.Sprintf(`
+%s
+
+data "fic_eri_router_v1" "router_1" {
+	name = "${fic_eri_router_v1.router_1.name}"
+	area = "${fic_eri_router_v1.router_1.area}"
+}
+`,
+	testAccEriV1RouterDataSourceRouter,
+)
+
+var testAccEriV1RouterDataSourceID = fmt.Sprintf(`
+%s
+
+data "fic_eri_router_v1" "router_1" {
+	router_id = "${fic_eri_router_v1.router_1.id}"
+}
+`,
+	testAccEriV1RouterDataSourceRouter,
+)
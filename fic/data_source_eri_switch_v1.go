@@ -1,20 +1,19 @@
 package fic
 
 import (
-	"fmt"
+	"context"
 	"log"
-	"strconv"
-	"strings"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/nttcom/go-fic/fic/eri/v1/switches"
 )
 
 func dataSourceEriSwitchV1() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceEriSwitchV1Read,
+		ReadContext: dataSourceEriSwitchV1Read,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -66,21 +65,21 @@ func dataSourceEriSwitchV1() *schema.Resource {
 	}
 }
 
-func dataSourceEriSwitchV1Read(d *schema.ResourceData, meta interface{}) error {
+func dataSourceEriSwitchV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	client, err := config.eriV1Client(GetRegion(d, config))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	pages, err := switches.List(client, nil).AllPages()
 	if err != nil {
-		return fmt.Errorf("unable to retrieve switches: %s", err)
+		return diag.Errorf("unable to retrieve switches: %s", err)
 	}
 
 	sws, err := switches.ExtractSwitches(pages)
 	if err != nil {
-		return fmt.Errorf("unable to extract switches: %s", err)
+		return diag.Errorf("unable to extract switches: %s", err)
 	}
 
 	opts := struct {
@@ -118,20 +117,17 @@ func dataSourceEriSwitchV1Read(d *schema.ResourceData, meta interface{}) error {
 			continue
 		}
 
-		for _, pt := range sw.PortTypes {
-			if pt.Available && opts.portType == pt.Type {
-				matches = append(matches, sw)
-				break
-			}
+		if switchPortTypeAvailable(&sw, opts.portType) {
+			matches = append(matches, sw)
 		}
 	}
 
 	if len(matches) == 0 {
-		return fmt.Errorf("your query returned no results. Please change your search criteria and try again")
+		return diag.Errorf("your query returned no results. Please change your search criteria and try again")
 	}
 
 	if len(matches) >= 2 {
-		return fmt.Errorf("your query returned more than one result. Please try a more specific search criteria")
+		return diag.Errorf("your query returned more than one result. Please try a more specific search criteria")
 	}
 
 	match := matches[0]
@@ -144,33 +140,22 @@ func dataSourceEriSwitchV1Read(d *schema.ResourceData, meta interface{}) error {
 	d.Set("location", match.Location)
 	d.Set("number_of_available_vlans", match.NumberOfAvailableVLANs)
 
-	var vlanRanges []map[string]int
-	for _, vr := range match.VLANRanges {
-		if !vr.Available {
-			continue
-		}
-
-		vlans := strings.Split(vr.Range, "-")
-		if len(vlans) != 2 {
-			return fmt.Errorf("vlan range is invalid format: %s", vr.Range)
-		}
+	vlanRanges, err := getSwitchVLANRangesForState(&match)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("vlan_ranges", vlanRanges)
 
-		start, err := strconv.Atoi(vlans[0])
-		if err != nil {
-			return fmt.Errorf("start of vlan range %s is not integer: %s", vr.Range, err)
-		}
+	return nil
+}
 
-		end, err := strconv.Atoi(vlans[1])
-		if err != nil {
-			return fmt.Errorf("end of vlan range %s is not integer: %s", vr.Range, err)
+func getSwitchVLANRangesForState(sw *switches.Switch) ([]map[string]interface{}, error) {
+	var ranges []string
+	for _, vr := range sw.VLANRanges {
+		if vr.Available {
+			ranges = append(ranges, vr.Range)
 		}
-
-		vlanRanges = append(vlanRanges, map[string]int{
-			"start": start,
-			"end":   end,
-		})
 	}
-	d.Set("vlan_ranges", vlanRanges)
 
-	return nil
+	return flattenVLANRanges(ranges)
 }
@@ -0,0 +1,203 @@
+package fic
+
+import (
+	"context"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nttcom/go-fic/fic/eri/v1/switches"
+)
+
+func dataSourceEriSwitchesV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceEriSwitchesV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"area": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"location": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"port_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"1G", "10G"}, false),
+			},
+
+			"sort_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"name", "number_of_available_vlans",
+				}, false),
+			},
+
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"switches": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"area": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"location": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port_types": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"number_of_available_vlans": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"vlan_ranges": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"start": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"end": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceEriSwitchesV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	client, err := config.eriV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	pages, err := switches.List(client, nil).AllPages()
+	if err != nil {
+		return diag.Errorf("unable to retrieve switches: %s", err)
+	}
+
+	sws, err := switches.ExtractSwitches(pages)
+	if err != nil {
+		return diag.Errorf("unable to extract switches: %s", err)
+	}
+
+	area := d.Get("area").(string)
+	location := d.Get("location").(string)
+	portType := d.Get("port_type").(string)
+
+	var matches []switches.Switch
+	for _, sw := range sws {
+		if area != "" && area != sw.Area {
+			continue
+		}
+
+		if location != "" && location != sw.Location {
+			continue
+		}
+
+		if portType != "" && !switchPortTypeAvailable(&sw, portType) {
+			continue
+		}
+
+		matches = append(matches, sw)
+	}
+
+	sortEriSwitchesV1(matches, d.Get("sort_by").(string))
+
+	var ids []string
+	var result []map[string]interface{}
+	for _, sw := range matches {
+		vlanRanges, err := getSwitchVLANRangesForState(&sw)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		var portTypes []string
+		for _, pt := range sw.PortTypes {
+			if pt.Available {
+				portTypes = append(portTypes, pt.Type)
+			}
+		}
+
+		ids = append(ids, sw.ID)
+		result = append(result, map[string]interface{}{
+			"id":                        sw.ID,
+			"name":                      sw.SwitchName,
+			"area":                      sw.Area,
+			"location":                  sw.Location,
+			"port_types":                portTypes,
+			"number_of_available_vlans": sw.NumberOfAvailableVLANs,
+			"vlan_ranges":               vlanRanges,
+		})
+	}
+
+	log.Printf("[DEBUG] Retrieved %d Eri Switches: %v", len(ids), ids)
+
+	d.SetId(strconv.Itoa(schema.HashString(strings.Join(ids, ","))))
+	d.Set("ids", ids)
+	d.Set("switches", result)
+
+	return nil
+}
+
+func switchPortTypeAvailable(sw *switches.Switch, portType string) bool {
+	for _, pt := range sw.PortTypes {
+		if pt.Available && portType == pt.Type {
+			return true
+		}
+	}
+	return false
+}
+
+// sortEriSwitchesV1 sorts switches in place. Switches are ordered by name
+// ascending, or by number of available VLANs descending so that the
+// switch with the most free VLANs comes first.
+func sortEriSwitchesV1(sws []switches.Switch, sortBy string) {
+	switch sortBy {
+	case "name":
+		sort.SliceStable(sws, func(i, j int) bool {
+			return sws[i].SwitchName < sws[j].SwitchName
+		})
+	case "number_of_available_vlans":
+		sort.SliceStable(sws, func(i, j int) bool {
+			return sws[i].NumberOfAvailableVLANs > sws[j].NumberOfAvailableVLANs
+		})
+	}
+}
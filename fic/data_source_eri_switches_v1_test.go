@@ -0,0 +1,78 @@
+package fic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/nttcom/go-fic/fic/eri/v1/switches"
+)
+
+func TestAccEriV1SwitchesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEriV1SwitchesDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.fic_eri_switches_v1.switches", "ids.0"),
+					resource.TestCheckResourceAttr(
+						"data.fic_eri_switches_v1.switches", "switches.0.area", OS_AREA_NAME),
+					resource.TestCheckResourceAttrSet(
+						"data.fic_eri_switches_v1.switches", "switches.0.name"),
+					resource.TestCheckResourceAttrSet(
+						"data.fic_eri_switches_v1.switches", "switches.0.number_of_available_vlans"),
+					resource.TestCheckResourceAttrSet(
+						"data.fic_eri_switches_v1.switches", "switches.0.vlan_ranges.0.start"),
+					resource.TestCheckResourceAttrSet(
+						"data.fic_eri_switches_v1.switches", "switches.0.vlan_ranges.0.end"),
+				),
+			},
+		},
+	})
+}
+
+func TestEriSwitchesV1Sort(t *testing.T) {
+	newSwitches := func() []switches.Switch {
+		return []switches.Switch{
+			{ID: "1", SwitchName: "lxea02comnw1", NumberOfAvailableVLANs: 16},
+			{ID: "2", SwitchName: "lxea01comnw1", NumberOfAvailableVLANs: 512},
+			{ID: "3", SwitchName: "lxea03comnw1", NumberOfAvailableVLANs: 128},
+		}
+	}
+
+	cases := []struct {
+		sortBy   string
+		expected []string
+	}{
+		{"", []string{"1", "2", "3"}},
+		{"name", []string{"2", "1", "3"}},
+		{"number_of_available_vlans", []string{"2", "3", "1"}},
+	}
+
+	for _, c := range cases {
+		sws := newSwitches()
+		sortEriSwitchesV1(sws, c.sortBy)
+
+		var actual []string
+		for _, sw := range sws {
+			actual = append(actual, sw.ID)
+		}
+
+		if fmt.Sprint(actual) != fmt.Sprint(c.expected) {
+			t.Fatalf("sort_by %q: expected %v, got %v", c.sortBy, c.expected, actual)
+		}
+	}
+}
+
+var testAccEriV1SwitchesDataSourceBasic = fmt.Sprintf(`
+data "fic_eri_switches_v1" "switches" {
+	area = "%s"
+	port_type = "1G"
+	sort_by = "number_of_available_vlans"
+}
+`,
+	OS_AREA_NAME,
+)
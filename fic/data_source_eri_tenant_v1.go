@@ -0,0 +1,110 @@
+package fic
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tokens3 "github.com/nttcom/go-fic/fic/identity/v3/tokens"
+)
+
+func dataSourceEriTenantV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceEriTenantV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tenant_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"domain_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"domain_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"user_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"user_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"roles": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceEriTenantV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	client, err := config.identityV3Client(config.OsClient)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Looking up the token the provider authenticated with tells
+	// the tenant it is scoped to without any extra credentials.
+	result := tokens3.Get(client, config.OsClient.Token())
+
+	if result.Err != nil {
+		return diag.Errorf("unable to retrieve token information: %s", result.Err)
+	}
+
+	project, err := result.ExtractProject()
+	if err != nil {
+		return diag.Errorf("unable to extract tenant: %s", err)
+	}
+
+	if project == nil {
+		return diag.Errorf("the token of the provider is not scoped to a tenant")
+	}
+
+	user, err := result.ExtractUser()
+	if err != nil {
+		return diag.Errorf("unable to extract user: %s", err)
+	}
+
+	roles, err := result.ExtractRoles()
+	if err != nil {
+		return diag.Errorf("unable to extract roles: %s", err)
+	}
+
+	log.Printf("[DEBUG] Retrieved Eri Tenant %s", project.ID)
+	d.SetId(project.ID)
+
+	d.Set("tenant_id", project.ID)
+	d.Set("tenant_name", project.Name)
+	d.Set("domain_id", project.Domain.ID)
+	d.Set("domain_name", project.Domain.Name)
+
+	if user != nil {
+		d.Set("user_id", user.ID)
+		d.Set("user_name", user.Name)
+	}
+
+	var roleNames []string
+	for _, r := range roles {
+		roleNames = append(roleNames, r.Name)
+	}
+	d.Set("roles", roleNames)
+
+	return nil
+}
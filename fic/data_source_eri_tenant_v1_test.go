@@ -0,0 +1,99 @@
+package fic
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nttcom/terraform-provider-fic/fic/testhelper/mock"
+)
+
+func TestAccEriV1TenantDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEriV1TenantDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.fic_eri_tenant_v1.tenant", "tenant_id"),
+					resource.TestCheckResourceAttrSet(
+						"data.fic_eri_tenant_v1.tenant", "user_id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccEriV1TenantDataSourceBasic = `
+data "fic_eri_tenant_v1" "tenant" {}
+`
+
+const testMockEriV1TenantToken = `
+request:
+  method: GET
+response:
+  code: 200
+  body: >
+    {
+      "token": {
+        "expires_at": "2018-11-28T02:48:52.111201Z",
+        "issued_at": "2018-11-28T01:48:52.111227Z",
+        "methods": ["password"],
+        "project": {
+          "domain": {"id": "default", "name": "Default"},
+          "id": "01234567890123456789abcdefabcdef",
+          "name": "FakeTenant"
+        },
+        "roles": [
+          {"id": "role-1", "name": "admin"},
+          {"id": "role-2", "name": "member"}
+        ],
+        "user": {
+          "domain": {"id": "default", "name": "Default"},
+          "id": "abcdef0123456789abcdef0123456789",
+          "name": "ThisIsADummyTenantUsername"
+        }
+      }
+    }
+`
+
+func TestEriTenantV1DataSource(t *testing.T) {
+	mc, config := testConfigWithMockKeystone(t, func(mc *mock.MockController) {
+		mc.Register(t, "token", "/v3/auth/tokens", testMockEriV1TenantToken)
+	})
+	defer mc.TerminateMockControllerSafety()
+
+	if err := config.LoadAndValidate(); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	ds := dataSourceEriTenantV1()
+	d := schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{})
+	if diags := ds.ReadContext(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	expected := map[string]string{
+		"tenant_id":   "01234567890123456789abcdefabcdef",
+		"tenant_name": "FakeTenant",
+		"domain_id":   "default",
+		"domain_name": "Default",
+		"user_id":     "abcdef0123456789abcdef0123456789",
+		"user_name":   "ThisIsADummyTenantUsername",
+		"roles.0":     "admin",
+		"roles.1":     "member",
+	}
+
+	for k, v := range expected {
+		if actual := d.Get(k).(string); actual != v {
+			t.Errorf("Expected %s to be %q, got %q", k, v, actual)
+		}
+	}
+
+	if d.Id() != expected["tenant_id"] {
+		t.Errorf("Expected ID %q, got %q", expected["tenant_id"], d.Id())
+	}
+}
@@ -267,3 +267,69 @@ response:
             }
         }
 `
+
+var fakeKeystonePostEriTmpl = `
+request:
+    method: POST
+response:
+    code: 201
+    body: >
+        {
+            "token": {
+                "audit_ids": [
+                    "DummyIds123456789abcde"
+                ],
+                "catalog": [
+                    {
+                        "endpoints": [
+                            {
+                                "id": "e4c383a719cb489d8210328e17659621",
+                                "interface": "public",
+                                "region": "RegionOne",
+                                "region_id": "RegionOne",
+                                "url": "%[1]spublic"
+                            },
+                            {
+                                "id": "e4c383a719cb489d8210328e17659622",
+                                "interface": "internal",
+                                "region": "RegionOne",
+                                "region_id": "RegionOne",
+                                "url": "%[1]sinternal"
+                            },
+                            {
+                                "id": "e4c383a719cb489d8210328e17659623",
+                                "interface": "admin",
+                                "region": "RegionOne",
+                                "region_id": "RegionOne",
+                                "url": "%[1]sadmin"
+                            }
+                        ],
+                        "id": "e4c383a719cb489d8210328e17659620",
+                        "name": "fic-eri",
+                        "type": "fic-eri"
+                    }
+                ],
+                "expires_at": "2018-11-28T02:48:52.111201Z",
+                "issued_at": "2018-11-28T01:48:52.111227Z",
+                "methods": [
+                    "password"
+                ],
+                "project": {
+                    "domain": {
+                        "id": "default",
+                        "name": "Default"
+                    },
+                    "id": "01234567890123456789abcdefabcdef",
+                    "name": "FakeTenant"
+                },
+                "user": {
+                    "domain": {
+                        "id": "default",
+                        "name": "Default"
+                    },
+                    "id": "abcdef0123456789abcdef0123456789",
+                    "name": "ThisIsADummyTenantUsername"
+                }
+            }
+        }
+`
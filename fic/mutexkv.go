@@ -0,0 +1,50 @@
+package fic
+
+import (
+	"log"
+	"sync"
+)
+
+// mutexKV is a simple key/value store for arbitrary mutexes. It is used to
+// serialize changes to a FIC Router across the resources which modify it.
+//
+// It replaces helper/mutexkv, which is not part of terraform-plugin-sdk v2.
+type mutexKV struct {
+	lock  sync.Mutex
+	store map[string]*sync.Mutex
+}
+
+// Lock locks the mutex for the given key.
+// The caller is responsible for calling Unlock for the same key.
+func (m *mutexKV) Lock(key string) {
+	log.Printf("[DEBUG] Locking %q", key)
+	m.get(key).Lock()
+	log.Printf("[DEBUG] Locked %q", key)
+}
+
+// Unlock unlocks the mutex for the given key.
+// The caller must have called Lock for the same key first.
+func (m *mutexKV) Unlock(key string) {
+	log.Printf("[DEBUG] Unlocking %q", key)
+	m.get(key).Unlock()
+	log.Printf("[DEBUG] Unlocked %q", key)
+}
+
+// get returns the mutex for the given key, no guarantee of its lock status.
+func (m *mutexKV) get(key string) *sync.Mutex {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	mutex, ok := m.store[key]
+	if !ok {
+		mutex = &sync.Mutex{}
+		m.store[key] = mutex
+	}
+	return mutex
+}
+
+func newMutexKV() *mutexKV {
+	return &mutexKV{
+		store: make(map[string]*sync.Mutex),
+	}
+}
@@ -1,16 +1,21 @@
 package fic
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/mutexkv"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 // This is a global MutexKV for use within this plugin.
-var osMutexKV = mutexkv.NewMutexKV()
+var osMutexKV = newMutexKV()
 
 // Provider returns a schema.Provider for Flexible InterConnect.
-func Provider() terraform.ResourceProvider {
+func Provider() *schema.Provider {
 	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"auth_url": &schema.Schema{
@@ -79,6 +84,35 @@ func Provider() terraform.ResourceProvider {
 				Description: descriptions["token"],
 			},
 
+			"application_credential_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_APPLICATION_CREDENTIAL_ID", ""),
+				Description: descriptions["application_credential_id"],
+			},
+
+			"application_credential_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_APPLICATION_CREDENTIAL_NAME", ""),
+				Description: descriptions["application_credential_name"],
+			},
+
+			"application_credential_secret": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_APPLICATION_CREDENTIAL_SECRET", ""),
+				Description: descriptions["application_credential_secret"],
+			},
+
+			"token_cache_dir": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_TOKEN_CACHE_DIR", ""),
+				Description: descriptions["token_cache_dir"],
+			},
+
 			"user_domain_name": &schema.Schema{
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -139,6 +173,7 @@ func Provider() terraform.ResourceProvider {
 				Type:        schema.TypeString,
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("OS_ENDPOINT_TYPE", ""),
+				Description: descriptions["endpoint_type"],
 			},
 
 			"cacert_file": &schema.Schema{
@@ -175,24 +210,103 @@ func Provider() terraform.ResourceProvider {
 				DefaultFunc: schema.EnvDefaultFunc("OS_FORCE_SSS_ENDPOINT", ""),
 				Description: descriptions["force_sss_endpoint"],
 			},
+
+			"max_retries": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("OS_MAX_RETRIES", 5),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  descriptions["max_retries"],
+			},
+
+			"retry_min_backoff": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("OS_RETRY_MIN_BACKOFF", "1s"),
+				ValidateFunc: ValidateDuration(),
+				Description:  descriptions["retry_min_backoff"],
+			},
+
+			"retry_max_backoff": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("OS_RETRY_MAX_BACKOFF", "30s"),
+				ValidateFunc: ValidateDuration(),
+				Description:  descriptions["retry_max_backoff"],
+			},
+
+			"max_concurrent_requests": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("OS_MAX_CONCURRENT_REQUESTS", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  descriptions["max_concurrent_requests"],
+			},
+
+			"requests_per_second": &schema.Schema{
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("OS_REQUESTS_PER_SECOND", 0.0),
+				ValidateFunc: validation.FloatAtLeast(0),
+				Description:  descriptions["requests_per_second"],
+			},
+
+			"endpoint_overrides": &schema.Schema{
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions["endpoint_overrides"],
+			},
+
+			"redact_log_keys": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions["redact_log_keys"],
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"fic_eri_switch_v1": dataSourceEriSwitchV1(),
+			"fic_eri_available_vlans_v1":                      dataSourceEriAvailableVLANsV1(),
+			"fic_eri_areas_v1":                                dataSourceEriAreasV1(),
+			"fic_eri_firewall_component_v1":                   dataSourceEriFirewallComponentV1(),
+			"fic_eri_nat_component_v1":                        dataSourceEriNATComponentV1(),
+			"fic_eri_nat_global_ip_address_sets_v1":           dataSourceEriNATGlobalIPAddressSetsV1(),
+			"fic_eri_operations_v1":                           dataSourceEriOperationsV1(),
+			"fic_eri_port_to_azure_microsoft_connection_v1":   dataSourceEriPortToAzureMicrosoftConnectionV1(),
+			"fic_eri_port_to_azure_private_connection_v1":     dataSourceEriPortToAzurePrivateConnectionV1(),
+			"fic_eri_port_to_port_connection_v1":              dataSourceEriPortToPortConnectionV1(),
+			"fic_eri_port_v1":                                 dataSourceEriPortV1(),
+			"fic_eri_ports_v1":                                dataSourceEriPortsV1(),
+			"fic_eri_router_paired_to_gcp_connection_v1":      dataSourceEriRouterPairedToGCPConnectionV1(),
+			"fic_eri_router_paired_to_port_connection_v1":     dataSourceEriRouterPairedToPortConnectionV1(),
+			"fic_eri_router_single_to_port_connection_v1":     dataSourceEriRouterSingleToPortConnectionV1(),
+			"fic_eri_router_to_azure_microsoft_connection_v1": dataSourceEriRouterToAzureMicrosoftConnectionV1(),
+			"fic_eri_router_to_azure_private_connection_v1":   dataSourceEriRouterToAzurePrivateConnectionV1(),
+			"fic_eri_router_to_ecl_connection_v1":             dataSourceEriRouterToECLConnectionV1(),
+			"fic_eri_router_to_uno_connection_v1":             dataSourceEriRouterToUNOConnectionV1(),
+			"fic_eri_router_v1":                               dataSourceEriRouterV1(),
+			"fic_eri_switch_v1":                               dataSourceEriSwitchV1(),
+			"fic_eri_switches_v1":                             dataSourceEriSwitchesV1(),
+			"fic_eri_tenant_v1":                               dataSourceEriTenantV1(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
 			"fic_eri_firewall_component_v1":                   resourceEriFirewallComponentV1(),
 			"fic_eri_nat_component_v1":                        resourceEriNATComponentV1(),
 			"fic_eri_nat_global_ip_address_set_v1":            resourceEriNATGlobalIPAddressSetV1(),
+			"fic_eri_port_to_aws_connection_v1":               resourceEriPortToAWSConnectionV1(),
 			"fic_eri_port_to_azure_microsoft_connection_v1":   resourceEriPortToAzureMicrosoftConnectionV1(),
 			"fic_eri_port_to_azure_private_connection_v1":     resourceEriPortToAzurePrivateConnectionV1(),
+			"fic_eri_port_to_gcp_connection_v1":               resourcePortToGCPConnection(),
 			"fic_eri_port_to_port_connection_v1":              resourceEriPortToPortConnectionV1(),
 			"fic_eri_port_v1":                                 resourceEriPortV1(),
 			"fic_eri_router_to_ecl_connection_v1":             resourceEriRouterToECLConnectionV1(),
 			"fic_eri_router_paired_to_gcp_connection_v1":      resourcePairedRouterToGCPConnection(),
 			"fic_eri_router_paired_to_port_connection_v1":     resourceEriRouterPairedToPortConnectionV1(),
+			"fic_eri_router_single_to_gcp_connection_v1":      resourceSingleRouterToGCPConnection(),
 			"fic_eri_router_single_to_port_connection_v1":     resourceEriRouterSingleToPortConnectionV1(),
+			"fic_eri_router_to_aws_connection_v1":             resourceEriRouterToAWSConnectionV1(),
 			"fic_eri_router_to_azure_microsoft_connection_v1": resourceEriRouterToAzureMicrosoftConnectionV1(),
 			"fic_eri_router_to_azure_private_connection_v1":   resourceEriRouterToAzurePrivateConnectionV1(),
 			"fic_eri_router_to_uno_connection_v1":             resourceEriRouterToUNOConnectionV1(),
@@ -200,7 +314,7 @@ func Provider() terraform.ResourceProvider {
 		},
 	}
 
-	provider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
+	provider.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		terraformVersion := provider.TerraformVersion
 		if terraformVersion == "" {
 			// Terraform 0.12 introduced this field to the protocol
@@ -235,6 +349,15 @@ func init() {
 
 		"token": "Authentication token to use as an alternative to username/password.",
 
+		"application_credential_id": "Application Credential ID to login with.",
+
+		"application_credential_name": "Application Credential name to login with.",
+
+		"application_credential_secret": "Application Credential secret to login with.",
+
+		"token_cache_dir": "A directory in which issued tokens are cached and reused\n" +
+			"until they expire.",
+
 		"user_domain_name": "The name of the domain where the user resides (Identity v3).",
 
 		"user_domain_id": "The ID of the domain where the user resides (Identity v3).",
@@ -260,32 +383,61 @@ func init() {
 		"key": "A client private key to authenticate with.",
 
 		"cloud": "An entry in a `clouds.yaml` file to use.",
+
+		"force_sss_endpoint": "The identity endpoint to authenticate against instead of `auth_url`.",
+
+		"max_retries": "How many times a request failing with a retryable status code\n" +
+			"(409, 429 or 503, and 500 for GET, HEAD, PUT and DELETE requests) is retried.",
+
+		"retry_min_backoff": "The time to wait before the first retry, e.g. `1s`.",
+
+		"retry_max_backoff": "The maximum time to wait between two retries, e.g. `30s`.",
+
+		"max_concurrent_requests": "The maximum number of requests in flight to the FIC API\n" +
+			"per tenant. 0 means unlimited.",
+
+		"requests_per_second": "The maximum number of requests per second sent to the FIC API\n" +
+			"per tenant. 0 means unlimited.",
+
+		"endpoint_overrides": "A map of services with an endpoint to use instead of the one\n" +
+			"found in the Keystone catalog.",
+
+		"redact_log_keys": "A list of JSON keys whose values are masked in the debug log\n" +
+			"in addition to the built-in ones.",
 	}
 }
 
-func configureProvider(d *schema.ResourceData, terraformVersion string) (interface{}, error) {
+func configureProvider(d *schema.ResourceData, terraformVersion string) (interface{}, diag.Diagnostics) {
 	config := Config{
-		CACertFile:        d.Get("cacert_file").(string),
-		ClientCertFile:    d.Get("cert").(string),
-		ClientKeyFile:     d.Get("key").(string),
-		Cloud:             d.Get("cloud").(string),
-		DefaultDomain:     d.Get("default_domain").(string),
-		DomainID:          d.Get("domain_id").(string),
-		DomainName:        d.Get("domain_name").(string),
-		EndpointType:      d.Get("endpoint_type").(string),
-		IdentityEndpoint:  d.Get("auth_url").(string),
-		Password:          d.Get("password").(string),
-		ProjectDomainID:   d.Get("project_domain_id").(string),
-		ProjectDomainName: d.Get("project_domain_name").(string),
-		Region:            d.Get("region").(string),
-		Token:             d.Get("token").(string),
-		TenantID:          d.Get("tenant_id").(string),
-		TenantName:        d.Get("tenant_name").(string),
-		UserDomainID:      d.Get("user_domain_id").(string),
-		UserDomainName:    d.Get("user_domain_name").(string),
-		Username:          d.Get("user_name").(string),
-		UserID:            d.Get("user_id").(string),
-		terraformVersion:  terraformVersion,
+		ApplicationCredentialID:     d.Get("application_credential_id").(string),
+		ApplicationCredentialName:   d.Get("application_credential_name").(string),
+		ApplicationCredentialSecret: d.Get("application_credential_secret").(string),
+		CACertFile:                  d.Get("cacert_file").(string),
+		ClientCertFile:              d.Get("cert").(string),
+		ClientKeyFile:               d.Get("key").(string),
+		Cloud:                       d.Get("cloud").(string),
+		DefaultDomain:               d.Get("default_domain").(string),
+		DomainID:                    d.Get("domain_id").(string),
+		DomainName:                  d.Get("domain_name").(string),
+		EndpointType:                d.Get("endpoint_type").(string),
+		ForceSSSEndpoint:            d.Get("force_sss_endpoint").(string),
+		IdentityEndpoint:            d.Get("auth_url").(string),
+		MaxConcurrent:               d.Get("max_concurrent_requests").(int),
+		MaxRetries:                  d.Get("max_retries").(int),
+		Password:                    d.Get("password").(string),
+		ProjectDomainID:             d.Get("project_domain_id").(string),
+		ProjectDomainName:           d.Get("project_domain_name").(string),
+		Region:                      d.Get("region").(string),
+		RequestsPerSecond:           d.Get("requests_per_second").(float64),
+		Token:                       d.Get("token").(string),
+		TokenCacheDir:               d.Get("token_cache_dir").(string),
+		TenantID:                    d.Get("tenant_id").(string),
+		TenantName:                  d.Get("tenant_name").(string),
+		UserDomainID:                d.Get("user_domain_id").(string),
+		UserDomainName:              d.Get("user_domain_name").(string),
+		Username:                    d.Get("user_name").(string),
+		UserID:                      d.Get("user_id").(string),
+		terraformVersion:            terraformVersion,
 	}
 
 	v, ok := d.GetOkExists("insecure")
@@ -294,8 +446,33 @@ func configureProvider(d *schema.ResourceData, terraformVersion string) (interfa
 		config.Insecure = &insecure
 	}
 
+	// The values have already been checked by ValidateDuration.
+	config.RetryMinBackoff, _ = time.ParseDuration(d.Get("retry_min_backoff").(string))
+	config.RetryMaxBackoff, _ = time.ParseDuration(d.Get("retry_max_backoff").(string))
+
+	for _, key := range d.Get("redact_log_keys").([]interface{}) {
+		config.RedactLogKeys = append(config.RedactLogKeys, key.(string))
+	}
+
+	if v, ok := d.GetOk("endpoint_overrides"); ok {
+		config.EndpointOverrides = make(map[string]string)
+		for service, endpoint := range v.(map[string]interface{}) {
+			config.EndpointOverrides[service] = endpoint.(string)
+		}
+	}
+
 	if err := config.LoadAndValidate(); err != nil {
-		return nil, err
+		var e *configError
+		if errors.As(err, &e) {
+			return nil, diag.Diagnostics{
+				{
+					Severity:      diag.Error,
+					Summary:       e.Error(),
+					AttributePath: cty.GetAttrPath(e.Attribute),
+				},
+			}
+		}
+		return nil, diag.FromErr(err)
 	}
 
 	return &config, nil
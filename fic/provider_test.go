@@ -1,16 +1,17 @@
 package fic
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"strings"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/pathorcontents"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
-	"github.com/terraform-providers/terraform-provider-google/google"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 var (
@@ -25,34 +26,27 @@ var (
 	OS_ECL_API_SECRET_KEY     = os.Getenv("OS_ECL_API_SECRET_KEY")
 	OS_AZURE_SERVICE_KEY      = os.Getenv("OS_AZURE_SERVICE_KEY")
 	OS_AZURE_SHARED_KEY       = os.Getenv("OS_AZURE_SHARED_KEY")
+	OS_AWS_ACCOUNT_ID         = os.Getenv("OS_AWS_ACCOUNT_ID")
 	OS_C_NUMBER               = os.Getenv("OS_C_NUMBER")
 	OS_PARENT_CONTRACT_NUMBER = os.Getenv("OS_PARENT_CONTRACT_NUMBER")
 	OS_VPN_NUMBER             = os.Getenv("OS_VPN_NUMBER")
 )
 
-var testAccProviders map[string]terraform.ResourceProvider
+var testAccProviders map[string]*schema.Provider
 var testAccProvider *schema.Provider
-var testAccProviderFactories func(providers *[]*schema.Provider) map[string]terraform.ResourceProviderFactory
+
+// testAccExternalProviders are the providers which acceptance tests download
+// from the registry, such as the Google provider for GCP connections.
+var testAccExternalProviders = map[string]resource.ExternalProvider{
+	"google": {
+		Source: "hashicorp/google",
+	},
+}
 
 func init() {
-	testAccProvider = Provider().(*schema.Provider)
-	testAccProviders = map[string]terraform.ResourceProvider{
-		"fic":    testAccProvider,
-		"google": google.Provider().(*schema.Provider),
-	}
-	testAccProviderFactories = func(providers *[]*schema.Provider) map[string]terraform.ResourceProviderFactory {
-		return map[string]terraform.ResourceProviderFactory{
-			"fic": func() (terraform.ResourceProvider, error) {
-				p := Provider()
-				*providers = append(*providers, p.(*schema.Provider))
-				return p, nil
-			},
-			"google": func() (terraform.ResourceProvider, error) {
-				p := google.Provider()
-				*providers = append(*providers, p.(*schema.Provider))
-				return p, nil
-			},
-		}
+	testAccProvider = Provider()
+	testAccProviders = map[string]*schema.Provider{
+		"fic": testAccProvider,
 	}
 }
 
@@ -115,6 +109,14 @@ func testAccPreCheckAzureConnection(t *testing.T) {
 	}
 }
 
+func testAccPreCheckAWSConnection(t *testing.T) {
+	testAccPreCheckRequiredEnvVars(t)
+
+	if OS_AWS_ACCOUNT_ID == "" {
+		t.Skip("Test for AWS Connection is skipped because OS_AWS_ACCOUNT_ID is not set.")
+	}
+}
+
 func testAccPreCheckGCPConnection(t *testing.T) {
 	testAccPreCheckRequiredEnvVars(t)
 
@@ -152,13 +154,13 @@ func testAccPreCheckUno(t *testing.T) {
 }
 
 func TestProvider(t *testing.T) {
-	if err := Provider().(*schema.Provider).InternalValidate(); err != nil {
+	if err := Provider().InternalValidate(); err != nil {
 		t.Fatalf("err: %s", err)
 	}
 }
 
 func TestProvider_impl(t *testing.T) {
-	var _ terraform.ResourceProvider = Provider()
+	var _ *schema.Provider = Provider()
 }
 
 // Steps for configuring Flexible InterConnect with SSL validation are here:
@@ -183,9 +185,9 @@ func TestAccProvider_caCertFile(t *testing.T) {
 		"cacert_file": caFile,
 	}
 
-	err = p.Configure(terraform.NewResourceConfigRaw(raw))
-	if err != nil {
-		t.Fatalf("Unexpected err when specifying FIC CA by file: %s", err)
+	diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(raw))
+	if diags.HasError() {
+		t.Fatalf("Unexpected err when specifying FIC CA by file: %v", diags)
 	}
 }
 
@@ -207,9 +209,9 @@ func TestAccProvider_caCertString(t *testing.T) {
 		"cacert_file": caContents,
 	}
 
-	err = p.Configure(terraform.NewResourceConfigRaw(raw))
-	if err != nil {
-		t.Fatalf("Unexpected err when specifying FIC CA by string: %s", err)
+	diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(raw))
+	if diags.HasError() {
+		t.Fatalf("Unexpected err when specifying FIC CA by string: %v", diags)
 	}
 }
 
@@ -239,9 +241,9 @@ func TestAccProvider_clientCertFile(t *testing.T) {
 		"key":  keyFile,
 	}
 
-	err = p.Configure(terraform.NewResourceConfigRaw(raw))
-	if err != nil {
-		t.Fatalf("Unexpected err when specifying FIC Client keypair by file: %s", err)
+	diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(raw))
+	if diags.HasError() {
+		t.Fatalf("Unexpected err when specifying FIC Client keypair by file: %v", diags)
 	}
 }
 
@@ -269,14 +271,14 @@ func TestAccProvider_clientCertString(t *testing.T) {
 		"key":  keyContents,
 	}
 
-	err = p.Configure(terraform.NewResourceConfigRaw(raw))
-	if err != nil {
-		t.Fatalf("Unexpected err when specifying FIC Client keypair by contents: %s", err)
+	diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(raw))
+	if diags.HasError() {
+		t.Fatalf("Unexpected err when specifying FIC Client keypair by contents: %v", diags)
 	}
 }
 
 func envVarContents(varName string) (string, error) {
-	contents, _, err := pathorcontents.Read(os.Getenv(varName))
+	contents, _, err := readPathOrContents(os.Getenv(varName))
 	if err != nil {
 		return "", fmt.Errorf("Error reading %s: %s", varName, err)
 	}
@@ -305,3 +307,21 @@ func envVarFile(varName string) (string, error) {
 }
 
 var stringMaxLength = strings.Repeat("a", 255)
+
+func TestProviderConfigure_attributePath(t *testing.T) {
+	p := Provider()
+
+	raw := map[string]interface{}{
+		"auth_url":      "https://example.com:5000/v3",
+		"endpoint_type": "private",
+	}
+
+	diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(raw))
+	if !diags.HasError() {
+		t.Fatal("Expected an error for an invalid endpoint_type")
+	}
+
+	if !diags[0].AttributePath.Equals(cty.GetAttrPath("endpoint_type")) {
+		t.Errorf("Expected the error to point at endpoint_type, got %#v", diags[0].AttributePath)
+	}
+}
@@ -1,14 +1,16 @@
 package fic
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/nttcom/go-fic"
 	"github.com/nttcom/go-fic/fic/eri/v1/routers/components/firewalls"
@@ -16,12 +18,12 @@ import (
 
 func resourceEriFirewallComponentV1() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceEriFirewallComponentV1Activate,
-		Read:   resourceEriFirewallComponentV1Read,
-		Update: resourceEriFirewallComponentV1Update,
-		Delete: resourceEriFirewallComponentV1Deactivate,
+		CreateContext: resourceEriFirewallComponentV1Activate,
+		ReadContext:   resourceEriFirewallComponentV1Read,
+		UpdateContext: resourceEriFirewallComponentV1Update,
+		DeleteContext: resourceEriFirewallComponentV1Deactivate,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -196,15 +198,17 @@ func resourceEriFirewallComponentV1() *schema.Resource {
 	}
 }
 
-func resourceEriFirewallComponentV1Activate(d *schema.ResourceData, meta interface{}) error {
+func resourceEriFirewallComponentV1Activate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	client, err := config.eriV1Client(GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI client: %s", err)
+		return diag.Errorf("Error creating FIC ERI client: %s", err)
 	}
 
 	routerID := d.Get("router_id").(string)
 	firewallID := d.Get("firewall_id").(string)
+	osMutexKV.Lock(routerID)
+	defer osMutexKV.Unlock(routerID)
 
 	activateOpts := &firewalls.ActivateOpts{
 		UserIPAddresses: getUserIPAddresses(d),
@@ -213,7 +217,7 @@ func resourceEriFirewallComponentV1Activate(d *schema.ResourceData, meta interfa
 	log.Printf("[DEBUG] Activate Options: %#v", activateOpts)
 	r, err := firewalls.Activate(client, routerID, firewallID, activateOpts).Extract()
 	if err != nil {
-		return fmt.Errorf("Error activating FIC ERI firewall component: %s", err)
+		return diag.Errorf("Error activating FIC ERI firewall component: %s", err)
 	}
 
 	id := fmt.Sprintf("%s/%s", routerID, firewallID)
@@ -233,9 +237,9 @@ func resourceEriFirewallComponentV1Activate(d *schema.ResourceData, meta interfa
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForStateContext(ctx)
 	if err != nil {
-		return fmt.Errorf(
+		return diag.Errorf(
 			"Error waiting for firewall component (%s) to become ready: %s", r.ID, err)
 	}
 
@@ -250,19 +254,19 @@ func resourceEriFirewallComponentV1Activate(d *schema.ResourceData, meta interfa
 	log.Printf("[DEBUG] RoutingGroupSettings  are set as: %#v", routingGroupSettings)
 
 	if len(rules) > 0 || len(customApplications) > 0 || len(applicationSets) > 0 || len(routingGroupSettings) > 0 {
-		if err := updateFirewall(d, meta); err != nil {
-			return fmt.Errorf("Error updating firewall component: %s", err)
+		if err := updateFirewall(ctx, d, meta); err != nil {
+			return diag.Errorf("Error updating firewall component: %s", err)
 		}
 	}
 
-	return resourceEriFirewallComponentV1Read(d, meta)
+	return resourceEriFirewallComponentV1Read(ctx, d, meta)
 }
 
-func resourceEriFirewallComponentV1Read(d *schema.ResourceData, meta interface{}) error {
+func resourceEriFirewallComponentV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	client, err := config.eriV1Client(GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI client: %s", err)
+		return diag.Errorf("Error creating FIC ERI client: %s", err)
 	}
 
 	id := d.Id()
@@ -270,7 +274,7 @@ func resourceEriFirewallComponentV1Read(d *schema.ResourceData, meta interface{}
 	firewallID := strings.Split(id, "/")[1]
 	r, err := firewalls.Get(client, routerID, firewallID).Extract()
 	if err != nil {
-		return CheckDeleted(d, err, "firewall")
+		return diag.FromErr(CheckDeleted(d, err, "firewall"))
 	}
 
 	log.Printf("[DEBUG] Retrieved firewall component %s: %+v", d.Id(), r)
@@ -289,19 +293,21 @@ func resourceEriFirewallComponentV1Read(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func resourceEriFirewallComponentV1Update(d *schema.ResourceData, meta interface{}) error {
-	if d.HasChange("rules") || d.HasChange("custom_applications") ||
-		d.HasChange("application_sets") || d.HasChange("routing_group_settings") {
+func resourceEriFirewallComponentV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	routerID := strings.Split(d.Id(), "/")[0]
+	osMutexKV.Lock(routerID)
+	defer osMutexKV.Unlock(routerID)
 
+	if attribute := changedAttribute(d, "rules", "custom_applications", "application_sets", "routing_group_settings"); attribute != "" {
 		log.Printf("[DEBUG] Firewall is going to update...")
-		if err := updateFirewall(d, meta); err != nil {
-			return fmt.Errorf("Error updating firewall component: %s", err)
+		if err := updateFirewall(ctx, d, meta); err != nil {
+			return attributeError(attribute, "Error updating firewall component", err)
 		}
 	}
-	return resourceEriFirewallComponentV1Read(d, meta)
+	return resourceEriFirewallComponentV1Read(ctx, d, meta)
 }
 
-func updateFirewall(d *schema.ResourceData, meta interface{}) error {
+func updateFirewall(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	client, err := config.eriV1Client(GetRegion(d, config))
 	if err != nil {
@@ -339,7 +345,7 @@ func updateFirewall(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		log.Printf("[DEBUG] Waiting for firewall component (%s) to become complete", d.Id())
-		_, err = activateStateConf.WaitForState()
+		_, err = activateStateConf.WaitForStateContext(ctx)
 		if err != nil {
 			return fmt.Errorf("Error waiting for firewall component (%s) to become complete: %s", d.Id(), err)
 		}
@@ -347,16 +353,19 @@ func updateFirewall(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceEriFirewallComponentV1Deactivate(d *schema.ResourceData, meta interface{}) error {
+func resourceEriFirewallComponentV1Deactivate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	client, err := config.eriV1Client(GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI client: %s", err)
+		return diag.Errorf("Error creating FIC ERI client: %s", err)
 	}
 
 	id := d.Id()
 	routerID := strings.Split(id, "/")[0]
 	natID := strings.Split(id, "/")[1]
+	osMutexKV.Lock(routerID)
+	defer osMutexKV.Unlock(routerID)
+
 	_, err = firewalls.Deactivate(client, routerID, natID).Extract()
 
 	log.Printf("[DEBUG] Waiting for firewall component (%s) to delete", d.Id())
@@ -370,9 +379,9 @@ func resourceEriFirewallComponentV1Deactivate(d *schema.ResourceData, meta inter
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForStateContext(ctx)
 	if err != nil {
-		return fmt.Errorf(
+		return diag.Errorf(
 			"Error waiting for firewall component (%s) to delete: %s",
 			d.Id(), err)
 	}
@@ -395,7 +404,7 @@ func FirewallComponentV1StateRefreshFunc(client *fic.ServiceClient, id string) r
 		}
 
 		if v.OperationStatus == "Error" {
-			return v, v.OperationStatus, fmt.Errorf("There was an error retrieving the firewall component information.")
+			return v, v.OperationStatus, operationError(client, v.OperationID, fmt.Errorf("There was an error retrieving the firewall component information."))
 		}
 
 		return v, v.OperationStatus, nil
@@ -1,14 +1,16 @@
 package fic
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/nttcom/go-fic"
 	"github.com/nttcom/go-fic/fic/eri/v1/routers/components/nats"
@@ -16,12 +18,12 @@ import (
 
 func resourceEriNATComponentV1() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceEriNATComponentV1Activate,
-		Read:   resourceEriNATComponentV1Read,
-		Update: resourceEriNATComponentV1Update,
-		Delete: resourceEriNATComponentV1Deactivate,
+		CreateContext: resourceEriNATComponentV1Activate,
+		ReadContext:   resourceEriNATComponentV1Read,
+		UpdateContext: resourceEriNATComponentV1Update,
+		DeleteContext: resourceEriNATComponentV1Deactivate,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -178,15 +180,17 @@ func resourceEriNATComponentV1() *schema.Resource {
 	}
 }
 
-func resourceEriNATComponentV1Activate(d *schema.ResourceData, meta interface{}) error {
+func resourceEriNATComponentV1Activate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	client, err := config.eriV1Client(GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI client: %s", err)
+		return diag.Errorf("Error creating FIC ERI client: %s", err)
 	}
 
 	routerID := d.Get("router_id").(string)
 	natID := d.Get("nat_id").(string)
+	osMutexKV.Lock(routerID)
+	defer osMutexKV.Unlock(routerID)
 
 	activateOpts := &nats.ActivateOpts{
 		UserIPAddresses:     getUserIPAddresses(d),
@@ -196,7 +200,7 @@ func resourceEriNATComponentV1Activate(d *schema.ResourceData, meta interface{})
 	log.Printf("[DEBUG] Activate Options: %#v", activateOpts)
 	r, err := nats.Activate(client, routerID, natID, activateOpts).Extract()
 	if err != nil {
-		return fmt.Errorf("Error activating FIC ERI nat component: %s", err)
+		return diag.Errorf("Error activating FIC ERI nat component: %s", err)
 	}
 
 	id := fmt.Sprintf("%s/%s", routerID, natID)
@@ -216,9 +220,9 @@ func resourceEriNATComponentV1Activate(d *schema.ResourceData, meta interface{})
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForStateContext(ctx)
 	if err != nil {
-		return fmt.Errorf(
+		return diag.Errorf(
 			"Error waiting for nat component (%s) to become ready: %s", r.ID, err)
 	}
 
@@ -229,19 +233,19 @@ func resourceEriNATComponentV1Activate(d *schema.ResourceData, meta interface{})
 	log.Printf("[DEBUG] Destination NAT Rule is set as: %#v", destinationNATRules)
 
 	if len(sourceNAPTRules) > 0 || len(destinationNATRules) > 0 {
-		if err := updateSourceNAPTORDestinationNAT(d, meta); err != nil {
-			return fmt.Errorf("Error updating nat component: %s", err)
+		if err := updateSourceNAPTORDestinationNAT(ctx, d, meta); err != nil {
+			return diag.Errorf("Error updating nat component: %s", err)
 		}
 	}
 
-	return resourceEriNATComponentV1Read(d, meta)
+	return resourceEriNATComponentV1Read(ctx, d, meta)
 }
 
-func resourceEriNATComponentV1Read(d *schema.ResourceData, meta interface{}) error {
+func resourceEriNATComponentV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	client, err := config.eriV1Client(GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI client: %s", err)
+		return diag.Errorf("Error creating FIC ERI client: %s", err)
 	}
 
 	id := d.Id()
@@ -249,7 +253,7 @@ func resourceEriNATComponentV1Read(d *schema.ResourceData, meta interface{}) err
 	natID := strings.Split(id, "/")[1]
 	r, err := nats.Get(client, routerID, natID).Extract()
 	if err != nil {
-		return CheckDeleted(d, err, "nat")
+		return diag.FromErr(CheckDeleted(d, err, "nat"))
 	}
 
 	log.Printf("[DEBUG] Retrieved nat component %s: %+v", d.Id(), r)
@@ -264,28 +268,35 @@ func resourceEriNATComponentV1Read(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-func resourceEriNATComponentV1Update(d *schema.ResourceData, meta interface{}) error {
+func resourceEriNATComponentV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	routerID := strings.Split(d.Id(), "/")[0]
+	osMutexKV.Lock(routerID)
+	defer osMutexKV.Unlock(routerID)
+
 	log.Printf("[DEBUG] d.HasChange('source_napt_rules'): %#v", d.HasChange("source_napt_rules"))
 	log.Printf("[DEBUG] d.HasChange('destination_nat_rules'): %#v", d.HasChange("source_napt_rules"))
-	if d.HasChange("source_napt_rules") || d.HasChange("destination_nat_rules") {
+	if attribute := changedAttribute(d, "source_napt_rules", "destination_nat_rules"); attribute != "" {
 		log.Printf("[DEBUG] Either Source NAPT or Destination NAT is going to update...")
-		if err := updateSourceNAPTORDestinationNAT(d, meta); err != nil {
-			return fmt.Errorf("Error updating nat component: %s", err)
+		if err := updateSourceNAPTORDestinationNAT(ctx, d, meta); err != nil {
+			return attributeError(attribute, "Error updating nat component", err)
 		}
 	}
-	return resourceEriNATComponentV1Read(d, meta)
+	return resourceEriNATComponentV1Read(ctx, d, meta)
 }
 
-func resourceEriNATComponentV1Deactivate(d *schema.ResourceData, meta interface{}) error {
+func resourceEriNATComponentV1Deactivate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	client, err := config.eriV1Client(GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI client: %s", err)
+		return diag.Errorf("Error creating FIC ERI client: %s", err)
 	}
 
 	id := d.Id()
 	routerID := strings.Split(id, "/")[0]
 	natID := strings.Split(id, "/")[1]
+	osMutexKV.Lock(routerID)
+	defer osMutexKV.Unlock(routerID)
+
 	_, err = nats.Deactivate(client, routerID, natID).Extract()
 
 	log.Printf("[DEBUG] Waiting for nat component (%s) to delete", d.Id())
@@ -299,9 +310,9 @@ func resourceEriNATComponentV1Deactivate(d *schema.ResourceData, meta interface{
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForStateContext(ctx)
 	if err != nil {
-		return fmt.Errorf(
+		return diag.Errorf(
 			"Error waiting for nat component (%s) to delete: %s",
 			d.Id(), err)
 	}
@@ -310,7 +321,7 @@ func resourceEriNATComponentV1Deactivate(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func updateSourceNAPTORDestinationNAT(d *schema.ResourceData, meta interface{}) error {
+func updateSourceNAPTORDestinationNAT(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	client, err := config.eriV1Client(GetRegion(d, config))
 	if err != nil {
@@ -345,7 +356,7 @@ func updateSourceNAPTORDestinationNAT(d *schema.ResourceData, meta interface{})
 		}
 
 		log.Printf("[DEBUG] Waiting for nat component (%s) to become complete", d.Id())
-		_, err = activateStateConf.WaitForState()
+		_, err = activateStateConf.WaitForStateContext(ctx)
 		if err != nil {
 			return fmt.Errorf("Error waiting for nat component (%s) to become complete: %s", d.Id(), err)
 		}
@@ -367,7 +378,7 @@ func NATComponentV1StateRefreshFunc(client *fic.ServiceClient, id string) resour
 		}
 
 		if v.OperationStatus == "Error" {
-			return v, v.OperationStatus, fmt.Errorf("There was an error retrieving the nat component information.")
+			return v, v.OperationStatus, operationError(client, v.OperationID, fmt.Errorf("There was an error retrieving the nat component information."))
 		}
 
 		return v, v.OperationStatus, nil
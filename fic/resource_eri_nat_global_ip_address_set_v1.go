@@ -1,14 +1,16 @@
 package fic
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/nttcom/go-fic"
 	"github.com/nttcom/go-fic/fic/eri/v1/routers/components/nat_global_ip_address_sets"
@@ -16,11 +18,11 @@ import (
 
 func resourceEriNATGlobalIPAddressSetV1() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceEriNATGlobalIPAddressSetV1Create,
-		Read:   resourceEriNATGlobalIPAddressSetV1Read,
-		Delete: resourceEriNATGlobalIPAddressSetV1Delete,
+		CreateContext: resourceEriNATGlobalIPAddressSetV1Create,
+		ReadContext:   resourceEriNATGlobalIPAddressSetV1Read,
+		DeleteContext: resourceEriNATGlobalIPAddressSetV1Delete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -72,15 +74,17 @@ func resourceEriNATGlobalIPAddressSetV1() *schema.Resource {
 	}
 }
 
-func resourceEriNATGlobalIPAddressSetV1Create(d *schema.ResourceData, meta interface{}) error {
+func resourceEriNATGlobalIPAddressSetV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	client, err := config.eriV1Client(GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI client: %s", err)
+		return diag.Errorf("Error creating FIC ERI client: %s", err)
 	}
 
 	routerID := d.Get("router_id").(string)
 	natID := d.Get("nat_id").(string)
+	osMutexKV.Lock(routerID)
+	defer osMutexKV.Unlock(routerID)
 
 	createOpts := &nat_global_ip_address_sets.CreateOpts{
 		Name:              d.Get("name").(string),
@@ -91,7 +95,7 @@ func resourceEriNATGlobalIPAddressSetV1Create(d *schema.ResourceData, meta inter
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
 	r, err := nat_global_ip_address_sets.Create(client, routerID, natID, createOpts).Extract()
 	if err != nil {
-		return fmt.Errorf("Error activating FIC ERI global ip address set: %s", err)
+		return diag.Errorf("Error activating FIC ERI global ip address set: %s", err)
 	}
 
 	globalIPAddressSetID := r.ID
@@ -112,19 +116,19 @@ func resourceEriNATGlobalIPAddressSetV1Create(d *schema.ResourceData, meta inter
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForStateContext(ctx)
 	if err != nil {
-		return fmt.Errorf(
+		return diag.Errorf(
 			"Error waiting for global ip address set (%s) to become ready: %s", r.ID, err)
 	}
-	return resourceEriNATGlobalIPAddressSetV1Read(d, meta)
+	return resourceEriNATGlobalIPAddressSetV1Read(ctx, d, meta)
 }
 
-func resourceEriNATGlobalIPAddressSetV1Read(d *schema.ResourceData, meta interface{}) error {
+func resourceEriNATGlobalIPAddressSetV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	client, err := config.eriV1Client(GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI client: %s", err)
+		return diag.Errorf("Error creating FIC ERI client: %s", err)
 	}
 
 	id := d.Id()
@@ -134,7 +138,7 @@ func resourceEriNATGlobalIPAddressSetV1Read(d *schema.ResourceData, meta interfa
 	r, err := nat_global_ip_address_sets.Get(
 		client, routerID, natID, globalIPAddressSetID).Extract()
 	if err != nil {
-		return CheckDeleted(d, err, "nat_global_ip_address_set")
+		return diag.FromErr(CheckDeleted(d, err, "nat_global_ip_address_set"))
 	}
 
 	log.Printf("[DEBUG] Retrieved global ip address set %s: %+v", d.Id(), r)
@@ -152,17 +156,20 @@ func resourceEriNATGlobalIPAddressSetV1Read(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceEriNATGlobalIPAddressSetV1Delete(d *schema.ResourceData, meta interface{}) error {
+func resourceEriNATGlobalIPAddressSetV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	client, err := config.eriV1Client(GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI client: %s", err)
+		return diag.Errorf("Error creating FIC ERI client: %s", err)
 	}
 
 	id := d.Id()
 	routerID := strings.Split(id, "/")[0]
 	natID := strings.Split(id, "/")[1]
 	globalIPAddressSetID := strings.Split(id, "/")[2]
+	osMutexKV.Lock(routerID)
+	defer osMutexKV.Unlock(routerID)
+
 	_, err = nat_global_ip_address_sets.Delete(
 		client, routerID, natID, globalIPAddressSetID).Extract()
 
@@ -177,9 +184,9 @@ func resourceEriNATGlobalIPAddressSetV1Delete(d *schema.ResourceData, meta inter
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForStateContext(ctx)
 	if err != nil {
-		return fmt.Errorf(
+		return diag.Errorf(
 			"Error waiting for global ip address set (%s) to delete: %s",
 			d.Id(), err)
 	}
@@ -203,7 +210,7 @@ func NATGlobalIPAddressSetV1StateRefreshFunc(client *fic.ServiceClient, id strin
 		}
 
 		if v.OperationStatus == "Error" {
-			return v, v.OperationStatus, fmt.Errorf("There was an error retrieving the global ip address set information.")
+			return v, v.OperationStatus, operationError(client, v.OperationID, fmt.Errorf("There was an error retrieving the global ip address set information."))
 		}
 
 		return v, v.OperationStatus, nil
@@ -0,0 +1,238 @@
+package fic
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nttcom/go-fic"
+	connections "github.com/nttcom/go-fic/fic/eri/v1/port_to_aws_connections"
+)
+
+func resourceEriPortToAWSConnectionV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceEriPortToAWSConnectionV1Create,
+		ReadContext:   resourceEriPortToAWSConnectionV1Read,
+		DeleteContext: resourceEriPortToAWSConnectionV1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"source_port_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"source_vlan": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"destination_interconnect": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"destination_aws_account_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(awsAccountIDRegexp, "must be a 12 digit AWS account ID"),
+			},
+
+			"destination_qos_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice(
+					[]string{"guarantee"}, false),
+			},
+
+			"bandwidth": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(awsConnectionBandwidths, false),
+			},
+
+			"redundant": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"operation_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"operation_status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceEriPortToAWSConnectionV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	client, err := config.eriV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating FIC ERI client: %s", err)
+	}
+
+	source := connections.Source{
+		PortID: d.Get("source_port_id").(string),
+		VLAN:   d.Get("source_vlan").(int),
+	}
+
+	destination := connections.Destination{
+		Interconnect: d.Get("destination_interconnect").(string),
+		AWSAccountID: d.Get("destination_aws_account_id").(string),
+		QosType:      d.Get("destination_qos_type").(string),
+	}
+
+	createOpts := &connections.CreateOpts{
+		Name:        d.Get("name").(string),
+		Source:      source,
+		Destination: destination,
+		Bandwidth:   d.Get("bandwidth").(string),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	r, err := connections.Create(client, createOpts).Extract()
+	if err != nil {
+		return diag.Errorf("Error creating FIC ERI port to aws connection: %s", err)
+	}
+
+	d.SetId(r.ID)
+
+	log.Printf("[INFO] Connection ID: %s", r.ID)
+
+	log.Printf(
+		"[DEBUG] Waiting for port to aws connection (%s) to become available", r.ID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"Processing"},
+		Target:     []string{"Completed"},
+		Refresh:    resourcePortToAWSConnectionV1StateRefreshFunc(client, r.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf(
+			"Error waiting for port to aws connection (%s) to become ready: %s", r.ID, err)
+	}
+
+	return resourceEriPortToAWSConnectionV1Read(ctx, d, meta)
+}
+
+func resourceEriPortToAWSConnectionV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	client, err := config.eriV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating FIC ERI client: %s", err)
+	}
+
+	r, err := connections.Get(client, d.Id()).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "connection"))
+	}
+
+	log.Printf("[DEBUG] Retrieved port to aws connection %s: %+v", d.Id(), r)
+
+	d.Set("name", r.Name)
+
+	d.Set("source_port_id", r.Source.PortID)
+	d.Set("source_vlan", r.Source.VLAN)
+
+	d.Set("destination_interconnect", r.Destination.Interconnect)
+	d.Set("destination_aws_account_id", r.Destination.AWSAccountID)
+	d.Set("destination_qos_type", r.Destination.QosType)
+
+	d.Set("bandwidth", r.Bandwidth)
+	d.Set("redundant", r.Redundant)
+	d.Set("tenant_id", r.TenantID)
+	d.Set("operation_id", r.OperationID)
+	d.Set("operation_status", r.OperationStatus)
+
+	return operationStatusWarning("connection", d.Id(), r.OperationStatus)
+}
+
+func resourceEriPortToAWSConnectionV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	client, err := config.eriV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating FIC ERI client: %s", err)
+	}
+
+	if err := connections.Delete(client, d.Id()).ExtractErr(); err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "connection"))
+	}
+
+	log.Printf("[DEBUG] Waiting for port to aws connection (%s) to delete", d.Id())
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"Processing", "Completed"},
+		Target:     []string{"Deleted"},
+		Refresh:    resourcePortToAWSConnectionV1StateRefreshFunc(client, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf(
+			"Error waiting for port to aws connection (%s) to delete: %s",
+			d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourcePortToAWSConnectionV1StateRefreshFunc(client *fic.ServiceClient, connectionID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		v, err := connections.Get(client, connectionID).Extract()
+		if err != nil {
+			if _, ok := err.(fic.ErrDefault404); ok {
+				return v, "Deleted", nil
+			}
+			return nil, "", err
+		}
+
+		if v.OperationStatus == "Error" {
+			return v, v.OperationStatus, operationError(client, v.OperationID, fmt.Errorf("there was an error retrieving the port to aws connection information"))
+		}
+
+		return v, v.OperationStatus, nil
+	}
+}
@@ -0,0 +1,226 @@
+package fic
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	connections "github.com/nttcom/go-fic/fic/eri/v1/port_to_aws_connections"
+	"github.com/nttcom/terraform-provider-fic/fic/testhelper/mock"
+)
+
+func TestAccEriPortToAWSConnectionV1Basic(t *testing.T) {
+	var c connections.Connection
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckSwitchName(t)
+			testAccPreCheckAWSConnection(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEriPortToAWSConnectionV1Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccConfigEriPortToAWSConnectionV1Basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEriPortToAWSConnectionV1Exists("fic_eri_port_to_aws_connection_v1.connection_1", &c),
+					resource.TestCheckResourceAttr(
+						"fic_eri_port_to_aws_connection_v1.connection_1", "source_vlan", "1137"),
+					resource.TestCheckResourceAttr(
+						"fic_eri_port_to_aws_connection_v1.connection_1", "operation_status", "Completed"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEriPortToAWSConnectionV1Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	client, err := config.eriV1Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating FIC ERI client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "fic_eri_port_to_aws_connection_v1" {
+			continue
+		}
+
+		_, err := connections.Get(client, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Connection still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckEriPortToAWSConnectionV1Exists(n string, c *connections.Connection) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		client, err := config.eriV1Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating FIC ERI client: %s", err)
+		}
+
+		found, err := connections.Get(client, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Connection not found")
+		}
+
+		*c = *found
+
+		return nil
+	}
+}
+
+var testAccConfigEriPortToAWSConnectionV1Basic = fmt.Sprintf(`
+resource "fic_eri_port_v1" "port_1" {
+	name = "terraform_port_1"
+	switch_name = "%s"
+	port_type = "1G"
+	is_activated = true
+
+	vlan_ranges {
+		start = 1137
+		end = 1152
+	}
+}
+
+resource "fic_eri_port_to_aws_connection_v1" "connection_1" {
+	name = "terraform_connection_1"
+	source_port_id = "${fic_eri_port_v1.port_1.id}"
+	source_vlan = "${fic_eri_port_v1.port_1.vlan_ranges.0.start}"
+	destination_interconnect = "Equinix-TY2-2"
+	destination_aws_account_id = "%s"
+	destination_qos_type = "guarantee"
+	bandwidth = "50M"
+}
+`,
+	OS_SWITCH_NAME,
+	OS_AWS_ACCOUNT_ID,
+)
+
+const testMockEriV1PortToAWSConnectionGet = `
+request:
+  method: GET
+response:
+  code: 200
+  body: >
+    {
+      "connection": {
+        "id": "F030000000000021",
+        "tenantId": "87e89b8f075a4ee1aa209f6ca6ce242c",
+        "name": "aws-port-1",
+        "redundant": false,
+        "bandwidth": "100M",
+        "source": {"portId": "F010123456789", "vlan": 1137},
+        "destination": {
+          "interconnect": "Equinix-TY2-2",
+          "awsAccountId": "123456789012",
+          "qosType": "guarantee"
+        },
+        "operationId": "cc43d0f05df24b1aabdea46456d46e39",
+        "operationStatus": "Completed"
+      }
+    }
+`
+
+const testMockEriV1PortToAWSConnectionError = `
+request:
+  method: GET
+response:
+  code: 200
+  body: >
+    {
+      "connection": {
+        "id": "F030000000000022",
+        "operationId": "cc43d0f05df24b1aabdea46456d46e39",
+        "operationStatus": "Error"
+      }
+    }
+`
+
+func TestEriPortToAWSConnectionV1Read(t *testing.T) {
+	mc, config := testConfigWithMockKeystone(t, func(mc *mock.MockController) {
+		mc.Register(t, "connection", "/public/v1/port-to-aws-connections/F030000000000021", testMockEriV1PortToAWSConnectionGet)
+	})
+	defer mc.TerminateMockControllerSafety()
+
+	if err := config.LoadAndValidate(); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	r := resourceEriPortToAWSConnectionV1()
+	d := r.TestResourceData()
+	d.SetId("F030000000000021")
+
+	if diags := r.ReadContext(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	expected := map[string]string{
+		"name":                       "aws-port-1",
+		"source_port_id":             "F010123456789",
+		"destination_interconnect":   "Equinix-TY2-2",
+		"destination_aws_account_id": "123456789012",
+		"destination_qos_type":       "guarantee",
+		"bandwidth":                  "100M",
+		"tenant_id":                  "87e89b8f075a4ee1aa209f6ca6ce242c",
+		"operation_id":               "cc43d0f05df24b1aabdea46456d46e39",
+		"operation_status":           "Completed",
+	}
+
+	for k, v := range expected {
+		if actual := d.Get(k).(string); actual != v {
+			t.Errorf("Expected %s to be %q, got %q", k, v, actual)
+		}
+	}
+
+	if v := d.Get("source_vlan").(int); v != 1137 {
+		t.Errorf("Expected source_vlan 1137, got %d", v)
+	}
+}
+
+func TestEriPortToAWSConnectionV1StateRefreshFunc(t *testing.T) {
+	mc, config := testConfigWithMockKeystone(t, func(mc *mock.MockController) {
+		mc.Register(t, "failed", "/public/v1/port-to-aws-connections/F030000000000022", testMockEriV1PortToAWSConnectionError)
+		mc.Register(t, "operation", "/public/v1/operations/cc43d0f05df24b1aabdea46456d46e39", testMockEriV1OperationError)
+	})
+	defer mc.TerminateMockControllerSafety()
+
+	if err := config.LoadAndValidate(); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	client, err := config.eriV1Client("")
+	if err != nil {
+		t.Fatalf("Unable to create ERI client: %s", err)
+	}
+
+	_, status, err := resourcePortToAWSConnectionV1StateRefreshFunc(client, "F030000000000022")()
+	if status != "Error" {
+		t.Errorf("Expected status Error, got %q", status)
+	}
+
+	if err == nil || !strings.Contains(err.Error(), "user ip address overlaps with an existing router") {
+		t.Errorf("Expected the error of the operation, got %v", err)
+	}
+}
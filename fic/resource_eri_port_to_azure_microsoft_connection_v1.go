@@ -1,25 +1,27 @@
 package fic
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/nttcom/go-fic"
 	connections "github.com/nttcom/go-fic/fic/eri/v1/port_to_azure_microsoft_connections"
 )
 
 func resourceEriPortToAzureMicrosoftConnectionV1() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceEriPortToAzureMicrosoftConnectionV1Create,
-		Read:   resourceEriPortToAzureMicrosoftConnectionV1Read,
-		Update: resourceEriPortToAzureMicrosoftConnectionV1Update,
-		Delete: resourceEriPortToAzureMicrosoftConnectionV1Delete,
+		CreateContext: resourceEriPortToAzureMicrosoftConnectionV1Create,
+		ReadContext:   resourceEriPortToAzureMicrosoftConnectionV1Read,
+		UpdateContext: resourceEriPortToAzureMicrosoftConnectionV1Update,
+		DeleteContext: resourceEriPortToAzureMicrosoftConnectionV1Delete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -79,15 +81,25 @@ func resourceEriPortToAzureMicrosoftConnectionV1() *schema.Resource {
 			},
 
 			"destination_service_key": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: suppressHashedSecretDiff,
 			},
 
 			"destination_shared_key": &schema.Schema{
-				Type:     schema.TypeString,
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: suppressHashedSecretDiff,
+			},
+
+			"write_only_secrets": &schema.Schema{
+				Type:     schema.TypeBool,
 				Optional: true,
-				ForceNew: true,
+				Default:  false,
 			},
 
 			"destination_advertised_public_prefixes": &schema.Schema{
@@ -157,11 +169,11 @@ func resourceEriPortToAzureMicrosoftConnectionV1() *schema.Resource {
 	}
 }
 
-func resourceEriPortToAzureMicrosoftConnectionV1Create(d *schema.ResourceData, meta interface{}) error {
+func resourceEriPortToAzureMicrosoftConnectionV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	client, err := config.eriV1Client(GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI client: %s", err)
+		return diag.Errorf("Error creating FIC ERI client: %s", err)
 	}
 
 	primary := connections.Primary{
@@ -206,7 +218,7 @@ func resourceEriPortToAzureMicrosoftConnectionV1Create(d *schema.ResourceData, m
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
 	r, err := connections.Create(client, createOpts).Extract()
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI port to azure microsoft connection: %s", err)
+		return diag.Errorf("Error creating FIC ERI port to azure microsoft connection: %s", err)
 	}
 
 	d.SetId(r.ID)
@@ -225,25 +237,25 @@ func resourceEriPortToAzureMicrosoftConnectionV1Create(d *schema.ResourceData, m
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForStateContext(ctx)
 	if err != nil {
-		return fmt.Errorf(
+		return diag.Errorf(
 			"Error waiting for port to azure microsoft connection (%s) to become ready: %s", r.ID, err)
 	}
 
-	return resourceEriPortToAzureMicrosoftConnectionV1Read(d, meta)
+	return resourceEriPortToAzureMicrosoftConnectionV1Read(ctx, d, meta)
 }
 
-func resourceEriPortToAzureMicrosoftConnectionV1Read(d *schema.ResourceData, meta interface{}) error {
+func resourceEriPortToAzureMicrosoftConnectionV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	client, err := config.eriV1Client(GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI client: %s", err)
+		return diag.Errorf("Error creating FIC ERI client: %s", err)
 	}
 
 	r, err := connections.Get(client, d.Id()).Extract()
 	if err != nil {
-		return CheckDeleted(d, err, "connection")
+		return diag.FromErr(CheckDeleted(d, err, "connection"))
 	}
 
 	log.Printf("[DEBUG] Retrieved port to azure microsoft connection %s: %+v", d.Id(), r)
@@ -260,8 +272,8 @@ func resourceEriPortToAzureMicrosoftConnectionV1Read(d *schema.ResourceData, met
 
 	d.Set("destination_interconnect", r.Destination.Interconnect)
 	d.Set("destination_qos_type", r.Destination.QosType)
-	d.Set("destination_service_key", r.Destination.ServiceKey)
-	d.Set("destination_shared_key", r.Destination.SharedKey)
+	d.Set("destination_service_key", secretForState(d, r.Destination.ServiceKey))
+	d.Set("destination_shared_key", secretForState(d, r.Destination.SharedKey))
 	d.Set("destination_advertised_public_prefixes", r.Destination.AdvertisedPublicPrefixes)
 	d.Set("destination_routing_registry_name", r.Destination.RoutingRegistryName)
 
@@ -273,17 +285,20 @@ func resourceEriPortToAzureMicrosoftConnectionV1Read(d *schema.ResourceData, met
 	d.Set("tenant_id", r.TenantID)
 	d.Set("area", r.Area)
 
-	return nil
+	d.Set("operation_id", r.OperationID)
+	d.Set("operation_status", r.OperationStatus)
+
+	return operationStatusWarning("connection", d.Id(), r.OperationStatus)
 }
 
-func resourceEriPortToAzureMicrosoftConnectionV1Update(d *schema.ResourceData, meta interface{}) error {
+func resourceEriPortToAzureMicrosoftConnectionV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	client, err := config.eriV1Client(GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI client: %s", err)
+		return diag.Errorf("Error creating FIC ERI client: %s", err)
 	}
 
-	if d.HasChange("destination_advertised_public_prefixes") || d.HasChange("destination_routing_registry_name") {
+	if attribute := changedAttribute(d, "destination_advertised_public_prefixes", "destination_routing_registry_name"); attribute != "" {
 		var advertisedPublicPrefixes []string
 		for _, p := range d.Get("destination_advertised_public_prefixes").([]interface{}) {
 			advertisedPublicPrefixes = append(advertisedPublicPrefixes, p.(string))
@@ -300,7 +315,7 @@ func resourceEriPortToAzureMicrosoftConnectionV1Update(d *schema.ResourceData, m
 
 		_, err := connections.Update(client, d.Id(), updateOpts).Extract()
 		if err != nil {
-			return fmt.Errorf("Error updating FIC ERI port to azure microsoft connection: %s", err)
+			return attributeError(attribute, "Error updating FIC ERI port to azure microsoft connection", err)
 		}
 
 		log.Printf(
@@ -316,24 +331,24 @@ func resourceEriPortToAzureMicrosoftConnectionV1Update(d *schema.ResourceData, m
 		}
 
 		log.Printf("[DEBUG] Waiting for port to azure microsoft connection (%s) to become complete", d.Id())
-		_, err = stateConf.WaitForState()
+		_, err = stateConf.WaitForStateContext(ctx)
 		if err != nil {
-			return fmt.Errorf("Error waiting for port to azure microsoft connection (%s) to become complete: %s", d.Id(), err)
+			return diag.Errorf("Error waiting for port to azure microsoft connection (%s) to become complete: %s", d.Id(), err)
 		}
 	}
 
-	return resourceEriPortToAzureMicrosoftConnectionV1Read(d, meta)
+	return resourceEriPortToAzureMicrosoftConnectionV1Read(ctx, d, meta)
 }
 
-func resourceEriPortToAzureMicrosoftConnectionV1Delete(d *schema.ResourceData, meta interface{}) error {
+func resourceEriPortToAzureMicrosoftConnectionV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	client, err := config.eriV1Client(GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI client: %s", err)
+		return diag.Errorf("Error creating FIC ERI client: %s", err)
 	}
 
 	if err := connections.Delete(client, d.Id()).ExtractErr(); err != nil {
-		return CheckDeleted(d, err, "connection")
+		return diag.FromErr(CheckDeleted(d, err, "connection"))
 	}
 
 	log.Printf("[DEBUG] Waiting for port to azure microsoft connection (%s) to delete", d.Id())
@@ -347,9 +362,9 @@ func resourceEriPortToAzureMicrosoftConnectionV1Delete(d *schema.ResourceData, m
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForStateContext(ctx)
 	if err != nil {
-		return fmt.Errorf(
+		return diag.Errorf(
 			"Error waiting for port to azure microsoft connection (%s) to delete: %s",
 			d.Id(), err)
 	}
@@ -369,7 +384,7 @@ func resourcePortToAzureMicrosoftConnectionV1StateRefreshFunc(client *fic.Servic
 		}
 
 		if v.OperationStatus == "Error" {
-			return v, v.OperationStatus, fmt.Errorf("there was an error retrieving the port to azure microsoft connection information")
+			return v, v.OperationStatus, operationError(client, v.OperationID, fmt.Errorf("there was an error retrieving the port to azure microsoft connection information"))
 		}
 
 		return v, v.OperationStatus, nil
@@ -1,24 +1,27 @@
 package fic
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/nttcom/go-fic"
 	connections "github.com/nttcom/go-fic/fic/eri/v1/port_to_azure_private_connections"
 )
 
 func resourceEriPortToAzurePrivateConnectionV1() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceEriPortToAzurePrivateConnectionV1Create,
-		Read:   resourceEriPortToAzurePrivateConnectionV1Read,
-		Delete: resourceEriPortToAzurePrivateConnectionV1Delete,
+		CreateContext: resourceEriPortToAzurePrivateConnectionV1Create,
+		ReadContext:   resourceEriPortToAzurePrivateConnectionV1Read,
+		UpdateContext: resourceEriPortToAzurePrivateConnectionV1Update,
+		DeleteContext: resourceEriPortToAzurePrivateConnectionV1Delete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -78,15 +81,25 @@ func resourceEriPortToAzurePrivateConnectionV1() *schema.Resource {
 			},
 
 			"destination_service_key": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: suppressHashedSecretDiff,
 			},
 
 			"destination_shared_key": &schema.Schema{
-				Type:     schema.TypeString,
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: suppressHashedSecretDiff,
+			},
+
+			"write_only_secrets": &schema.Schema{
+				Type:     schema.TypeBool,
 				Optional: true,
-				ForceNew: true,
+				Default:  false,
 			},
 
 			"primary_connected_network_address": &schema.Schema{
@@ -141,11 +154,11 @@ func resourceEriPortToAzurePrivateConnectionV1() *schema.Resource {
 	}
 }
 
-func resourceEriPortToAzurePrivateConnectionV1Create(d *schema.ResourceData, meta interface{}) error {
+func resourceEriPortToAzurePrivateConnectionV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	client, err := config.eriV1Client(GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI client: %s", err)
+		return diag.Errorf("Error creating FIC ERI client: %s", err)
 	}
 
 	primary := connections.Primary{
@@ -183,7 +196,7 @@ func resourceEriPortToAzurePrivateConnectionV1Create(d *schema.ResourceData, met
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
 	r, err := connections.Create(client, createOpts).Extract()
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI port to azure private connection: %s", err)
+		return diag.Errorf("Error creating FIC ERI port to azure private connection: %s", err)
 	}
 
 	d.SetId(r.ID)
@@ -202,25 +215,25 @@ func resourceEriPortToAzurePrivateConnectionV1Create(d *schema.ResourceData, met
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForStateContext(ctx)
 	if err != nil {
-		return fmt.Errorf(
+		return diag.Errorf(
 			"Error waiting for port to azure private connection (%s) to become ready: %s", r.ID, err)
 	}
 
-	return resourceEriPortToAzurePrivateConnectionV1Read(d, meta)
+	return resourceEriPortToAzurePrivateConnectionV1Read(ctx, d, meta)
 }
 
-func resourceEriPortToAzurePrivateConnectionV1Read(d *schema.ResourceData, meta interface{}) error {
+func resourceEriPortToAzurePrivateConnectionV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	client, err := config.eriV1Client(GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI client: %s", err)
+		return diag.Errorf("Error creating FIC ERI client: %s", err)
 	}
 
 	r, err := connections.Get(client, d.Id()).Extract()
 	if err != nil {
-		return CheckDeleted(d, err, "connection")
+		return diag.FromErr(CheckDeleted(d, err, "connection"))
 	}
 
 	log.Printf("[DEBUG] Retrieved port to azure private connection %s: %+v", d.Id(), r)
@@ -237,8 +250,8 @@ func resourceEriPortToAzurePrivateConnectionV1Read(d *schema.ResourceData, meta
 
 	d.Set("destination_interconnect", r.Destination.Interconnect)
 	d.Set("destination_qos_type", r.Destination.QosType)
-	d.Set("destination_service_key", r.Destination.ServiceKey)
-	d.Set("destination_shared_key", r.Destination.SharedKey)
+	d.Set("destination_service_key", secretForState(d, r.Destination.ServiceKey))
+	d.Set("destination_shared_key", secretForState(d, r.Destination.SharedKey))
 
 	d.Set("primary_connected_network_address", r.PrimaryConnectedNetworkAddress)
 	d.Set("secondary_connected_network_address", r.SecondaryConnectedNetworkAddress)
@@ -248,18 +261,27 @@ func resourceEriPortToAzurePrivateConnectionV1Read(d *schema.ResourceData, meta
 	d.Set("tenant_id", r.TenantID)
 	d.Set("area", r.Area)
 
-	return nil
+	d.Set("operation_id", r.OperationID)
+	d.Set("operation_status", r.OperationStatus)
+
+	return operationStatusWarning("connection", d.Id(), r.OperationStatus)
+}
+
+func resourceEriPortToAzurePrivateConnectionV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Every argument of the connection except write_only_secrets forces a
+	// new resource, so only the state has to be refreshed here.
+	return resourceEriPortToAzurePrivateConnectionV1Read(ctx, d, meta)
 }
 
-func resourceEriPortToAzurePrivateConnectionV1Delete(d *schema.ResourceData, meta interface{}) error {
+func resourceEriPortToAzurePrivateConnectionV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	client, err := config.eriV1Client(GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI client: %s", err)
+		return diag.Errorf("Error creating FIC ERI client: %s", err)
 	}
 
 	if err := connections.Delete(client, d.Id()).ExtractErr(); err != nil {
-		return CheckDeleted(d, err, "connection")
+		return diag.FromErr(CheckDeleted(d, err, "connection"))
 	}
 
 	log.Printf("[DEBUG] Waiting for port to azure private connection (%s) to delete", d.Id())
@@ -273,9 +295,9 @@ func resourceEriPortToAzurePrivateConnectionV1Delete(d *schema.ResourceData, met
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForStateContext(ctx)
 	if err != nil {
-		return fmt.Errorf(
+		return diag.Errorf(
 			"Error waiting for port to azure private connection (%s) to delete: %s",
 			d.Id(), err)
 	}
@@ -295,7 +317,7 @@ func resourcePortToAzurePrivateConnectionV1StateRefreshFunc(client *fic.ServiceC
 		}
 
 		if v.OperationStatus == "Error" {
-			return v, v.OperationStatus, fmt.Errorf("there was an error retrieving the port to azure private connection information")
+			return v, v.OperationStatus, operationError(client, v.OperationID, fmt.Errorf("there was an error retrieving the port to azure private connection information"))
 		}
 
 		return v, v.OperationStatus, nil
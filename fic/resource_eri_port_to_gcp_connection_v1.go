@@ -0,0 +1,245 @@
+package fic
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nttcom/go-fic"
+	connections "github.com/nttcom/go-fic/fic/eri/v1/port_to_gcp_connections"
+)
+
+func resourcePortToGCPConnection() *schema.Resource {
+	destinationSchema := gcpInterconnectSchema()
+	destinationSchema.Schema["qos_type"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+
+	return &schema.Resource{
+		CreateContext: resourcePortToGCPConnectionCreate,
+		ReadContext:   resourcePortToGCPConnectionRead,
+		DeleteContext: resourcePortToGCPConnectionDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[\w&()-]{1,64}$`), "must be less than 64 characters in half-width alphanumeric characters and some symbols &()-_"),
+			},
+			"bandwidth": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"10M", "50M", "100M", "200M", "300M", "400M", "500M", "1G", "2G", "5G", "10G"}, false),
+			},
+			"source": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"port_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^F\d{12}$`), "must be a F + 12-digit number"),
+						},
+						"vlan": {
+							Type:     schema.TypeInt,
+							Required: true,
+							ForceNew: true,
+						},
+						"asn": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"destination": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem:     destinationSchema,
+			},
+			"redundant": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"operation_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"operation_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"primary_connected_network_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourcePortToGCPConnectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	client, err := config.eriV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("error creating FIC client: %s", err)
+	}
+
+	source := d.Get("source").([]interface{})[0].(map[string]interface{})
+	destination := d.Get("destination").([]interface{})[0].(map[string]interface{})
+
+	opts := &connections.CreateOpts{
+		Name: d.Get("name").(string),
+		Source: connections.Source{
+			PortID: source["port_id"].(string),
+			VLAN:   source["vlan"].(int),
+			ASN:    source["asn"].(string),
+		},
+		Destination: connections.Destination{
+			Interconnect: destination["interconnect"].(string),
+			PairingKey:   destination["pairing_key"].(string),
+			QosType:      "guarantee",
+		},
+		Bandwidth: d.Get("bandwidth").(string),
+	}
+
+	conn, err := connections.Create(client, opts).Extract()
+	if err != nil {
+		return diag.Errorf("error creating FIC port to GCP connection: %s", err)
+	}
+
+	d.SetId(conn.ID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"Processing"},
+		Target:     []string{"Completed"},
+		Refresh:    portToGCPConnectionRefresh(client, conn.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for connection (%s) to become ready: %s", conn.ID, err)
+	}
+
+	d.Set("operation_id", conn.OperationID)
+
+	return resourcePortToGCPConnectionRead(ctx, d, meta)
+}
+
+func portToGCPConnectionRefresh(c *fic.ServiceClient, id string) func() (interface{}, string, error) {
+	return func() (interface{}, string, error) {
+		conn, err := connections.Get(c, id).Extract()
+		if err != nil {
+			var e fic.ErrDefault404
+			if errors.As(err, &e) {
+				return nil, "", nil
+			}
+			return nil, "", err
+		}
+
+		if conn.OperationStatus == "Error" {
+			return conn, conn.OperationStatus, operationError(c, conn.OperationID, errors.New("there was an error retrieving the port to GCP connection information"))
+		}
+
+		return conn, conn.OperationStatus, nil
+	}
+}
+
+func resourcePortToGCPConnectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	client, err := config.eriV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("error creating FIC client: %s", err)
+	}
+
+	conn, err := connections.Get(client, d.Id()).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "error getting FIC port to GCP connection"))
+	}
+
+	d.Set("name", conn.Name)
+	d.Set("bandwidth", conn.Bandwidth)
+	d.Set("source", []interface{}{
+		map[string]interface{}{
+			"port_id": conn.Source.PortID,
+			"vlan":    conn.Source.VLAN,
+			"asn":     conn.Source.ASN,
+		},
+	})
+	d.Set("destination", []interface{}{
+		map[string]interface{}{
+			"interconnect": conn.Destination.Interconnect,
+			"pairing_key":  conn.Destination.PairingKey,
+			"qos_type":     conn.Destination.QosType,
+		},
+	})
+	d.Set("redundant", conn.Redundant)
+	d.Set("tenant_id", conn.TenantID)
+	d.Set("operation_status", conn.OperationStatus)
+	d.Set("primary_connected_network_address", conn.PrimaryConnectedNetworkAddress)
+
+	return operationStatusWarning("connection", d.Id(), conn.OperationStatus)
+}
+
+func resourcePortToGCPConnectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	client, err := config.eriV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("error creating FIC client: %s", err)
+	}
+
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		if err := connections.Delete(client, d.Id()).ExtractErr(); err != nil {
+			var e404 fic.ErrDefault404
+			if errors.As(err, &e404) {
+				return nil
+			}
+
+			var e409 fic.ErrDefault409
+			if errors.As(err, &e409) {
+				return resource.RetryableError(err)
+			}
+
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return diag.Errorf("error deleting FIC port to GCP connection: %s", err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
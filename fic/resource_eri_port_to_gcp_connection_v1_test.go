@@ -0,0 +1,233 @@
+package fic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+
+	"github.com/nttcom/go-fic"
+
+	connections "github.com/nttcom/go-fic/fic/eri/v1/port_to_gcp_connections"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/nttcom/terraform-provider-fic/fic/testhelper/mock"
+)
+
+func TestAccPortToGCPConnection_basic(t *testing.T) {
+	var connection connections.Connection
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "fic_eri_port_to_gcp_connection_v1.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckGCPConnection(t)
+			testAccPreCheckSwitchName(t)
+		},
+		Providers:         testAccProviders,
+		ExternalProviders: testAccExternalProviders,
+		CheckDestroy:      testAccCheckPortToGCPConnectionDestroy,
+		IDRefreshName:     resourceName,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPortToGCPConnectionConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPortToGCPConnectionExists(resourceName, &connection),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth", "10M"),
+					resource.TestCheckResourceAttrSet(resourceName, "source.0.port_id"),
+					resource.TestCheckResourceAttr(resourceName, "source.0.vlan", "1137"),
+					resource.TestCheckResourceAttr(resourceName, "source.0.asn", "65530"),
+					resource.TestCheckResourceAttr(resourceName, "destination.0.interconnect", "Equinix-TY2-2"),
+					resource.TestCheckResourceAttrSet(resourceName, "destination.0.pairing_key"),
+					resource.TestCheckResourceAttr(resourceName, "destination.0.qos_type", "guarantee"),
+					resource.TestCheckResourceAttr(resourceName, "redundant", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "tenant_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "operation_id"),
+					resource.TestCheckResourceAttr(resourceName, "operation_status", "Completed"),
+					resource.TestCheckResourceAttrSet(resourceName, "primary_connected_network_address"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"operation_id",
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckPortToGCPConnectionExists(resourceName string, connection *connections.Connection) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("id is not set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		client, err := config.eriV1Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating FIC client: %w", err)
+		}
+
+		actual, err := connections.Get(client, rs.Primary.ID).Extract()
+		if err != nil {
+			return fmt.Errorf("error getting FIC port to GCP connection: %w", err)
+		}
+
+		*connection = *actual
+
+		return nil
+	}
+}
+
+func testAccCheckPortToGCPConnectionDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	client, err := config.eriV1Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating FIC client: %w", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "fic_eri_port_to_gcp_connection_v1" {
+			continue
+		}
+
+		if result := connections.Get(client, rs.Primary.ID); result.Err != nil {
+			var e fic.ErrDefault404
+			if errors.As(result.Err, &e) {
+				return nil
+			}
+			return fmt.Errorf("error getting FIC port to GCP connection: %w", result.Err)
+		}
+
+		return fmt.Errorf("connection (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccPortToGCPConnectionConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "google_compute_router" "test" {
+	name = %[1]q
+	network = "default"
+	bgp {
+		asn = 16550
+	}
+}
+
+resource "google_compute_interconnect_attachment" "test" {
+	name = %[1]q
+	router = google_compute_router.test.id
+	type = "PARTNER"
+	edge_availability_domain = "AVAILABILITY_DOMAIN_1"
+}
+
+resource "fic_eri_port_v1" "test" {
+	name = %[1]q
+	switch_name = %[2]q
+	port_type = "1G"
+	is_activated = true
+
+	vlan_ranges {
+		start = 1137
+		end = 1152
+	}
+}
+
+resource "fic_eri_port_to_gcp_connection_v1" "test" {
+	name = %[1]q
+	bandwidth = "10M"
+	source {
+		port_id = fic_eri_port_v1.test.id
+		vlan = fic_eri_port_v1.test.vlan_ranges.0.start
+		asn = "65530"
+	}
+	destination {
+		interconnect = "Equinix-TY2-2"
+		pairing_key = google_compute_interconnect_attachment.test.pairing_key
+	}
+}
+`, rName, OS_SWITCH_NAME)
+}
+
+const testMockEriV1PortToGCPConnectionGet = `
+request:
+  method: GET
+response:
+  code: 200
+  body: >
+    {
+      "connection": {
+        "id": "F030000000000031",
+        "tenantId": "87e89b8f075a4ee1aa209f6ca6ce242c",
+        "name": "gcp-port-1",
+        "redundant": false,
+        "bandwidth": "100M",
+        "source": {"portId": "F010123456789", "vlan": 1137, "asn": "65530"},
+        "destination": {
+          "interconnect": "Equinix-TY2-2",
+          "pairingKey": "7e51371e-72a3-40b5-b844-2e3efefaee59/asia-northeast1/1",
+          "qosType": "guarantee"
+        },
+        "primaryConnectedNwAddress": "169.254.0.0/29",
+        "operationId": "cc43d0f05df24b1aabdea46456d46e39",
+        "operationStatus": "Completed"
+      }
+    }
+`
+
+func TestPortToGCPConnectionRead(t *testing.T) {
+	mc, config := testConfigWithMockKeystone(t, func(mc *mock.MockController) {
+		mc.Register(t, "connection", "/public/v1/port-to-gcp-connections/F030000000000031", testMockEriV1PortToGCPConnectionGet)
+	})
+	defer mc.TerminateMockControllerSafety()
+
+	if err := config.LoadAndValidate(); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	r := resourcePortToGCPConnection()
+	d := r.TestResourceData()
+	d.SetId("F030000000000031")
+
+	if diags := r.ReadContext(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	expected := map[string]string{
+		"name":                              "gcp-port-1",
+		"bandwidth":                         "100M",
+		"source.0.port_id":                  "F010123456789",
+		"source.0.asn":                      "65530",
+		"destination.0.interconnect":        "Equinix-TY2-2",
+		"destination.0.pairing_key":         "7e51371e-72a3-40b5-b844-2e3efefaee59/asia-northeast1/1",
+		"destination.0.qos_type":            "guarantee",
+		"tenant_id":                         "87e89b8f075a4ee1aa209f6ca6ce242c",
+		"operation_status":                  "Completed",
+		"primary_connected_network_address": "169.254.0.0/29",
+	}
+
+	for k, v := range expected {
+		if actual := d.Get(k).(string); actual != v {
+			t.Errorf("Expected %s to be %q, got %q", k, v, actual)
+		}
+	}
+
+	if v := d.Get("source.0.vlan").(int); v != 1137 {
+		t.Errorf("Expected source.0.vlan 1137, got %d", v)
+	}
+}
@@ -1,13 +1,15 @@
 package fic
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/nttcom/go-fic"
 	connections "github.com/nttcom/go-fic/fic/eri/v1/port_to_port_connections"
@@ -15,11 +17,11 @@ import (
 
 func resourceEriPortToPortConnectionV1() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceEriPortToPortConnectionV1Create,
-		Read:   resourceEriPortToPortConnectionV1Read,
-		Delete: resourceEriPortToPortConnectionV1Delete,
+		CreateContext: resourceEriPortToPortConnectionV1Create,
+		ReadContext:   resourceEriPortToPortConnectionV1Read,
+		DeleteContext: resourceEriPortToPortConnectionV1Delete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -82,15 +84,25 @@ func resourceEriPortToPortConnectionV1() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+
+			"operation_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"operation_status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
 
-func resourceEriPortToPortConnectionV1Create(d *schema.ResourceData, meta interface{}) error {
+func resourceEriPortToPortConnectionV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	client, err := config.eriV1Client(GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI client: %s", err)
+		return diag.Errorf("Error creating FIC ERI client: %s", err)
 	}
 
 	source := connections.Source{
@@ -113,7 +125,7 @@ func resourceEriPortToPortConnectionV1Create(d *schema.ResourceData, meta interf
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
 	r, err := connections.Create(client, createOpts).Extract()
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI connection(port to port): %s", err)
+		return diag.Errorf("Error creating FIC ERI connection(port to port): %s", err)
 	}
 
 	d.SetId(r.ID)
@@ -132,25 +144,25 @@ func resourceEriPortToPortConnectionV1Create(d *schema.ResourceData, meta interf
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForStateContext(ctx)
 	if err != nil {
-		return fmt.Errorf(
+		return diag.Errorf(
 			"Error waiting for connection (%s) to become ready: %s", r.ID, err)
 	}
 
-	return resourceEriPortToPortConnectionV1Read(d, meta)
+	return resourceEriPortToPortConnectionV1Read(ctx, d, meta)
 }
 
-func resourceEriPortToPortConnectionV1Read(d *schema.ResourceData, meta interface{}) error {
+func resourceEriPortToPortConnectionV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	client, err := config.eriV1Client(GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI client: %s", err)
+		return diag.Errorf("Error creating FIC ERI client: %s", err)
 	}
 
 	r, err := connections.Get(client, d.Id()).Extract()
 	if err != nil {
-		return CheckDeleted(d, err, "connection")
+		return diag.FromErr(CheckDeleted(d, err, "connection"))
 	}
 
 	log.Printf("[DEBUG] Retrieved connection %s: %+v", d.Id(), r)
@@ -168,18 +180,21 @@ func resourceEriPortToPortConnectionV1Read(d *schema.ResourceData, meta interfac
 	d.Set("tenant_id", r.TenantID)
 	d.Set("area", r.Area)
 
-	return nil
+	d.Set("operation_id", r.OperationID)
+	d.Set("operation_status", r.OperationStatus)
+
+	return operationStatusWarning("connection", d.Id(), r.OperationStatus)
 }
 
-func resourceEriPortToPortConnectionV1Delete(d *schema.ResourceData, meta interface{}) error {
+func resourceEriPortToPortConnectionV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	client, err := config.eriV1Client(GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI client: %s", err)
+		return diag.Errorf("Error creating FIC ERI client: %s", err)
 	}
 
 	if err := connections.Delete(client, d.Id()).ExtractErr(); err != nil {
-		return CheckDeleted(d, err, "connection")
+		return diag.FromErr(CheckDeleted(d, err, "connection"))
 	}
 
 	log.Printf("[DEBUG] Waiting for connection (%s) to delete", d.Id())
@@ -193,9 +208,9 @@ func resourceEriPortToPortConnectionV1Delete(d *schema.ResourceData, meta interf
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForStateContext(ctx)
 	if err != nil {
-		return fmt.Errorf(
+		return diag.Errorf(
 			"Error waiting for connection (%s) to delete: %s",
 			d.Id(), err)
 	}
@@ -215,7 +230,7 @@ func PortToPortConnectionV1StateRefreshFunc(client *fic.ServiceClient, connectio
 		}
 
 		if v.OperationStatus == "Error" {
-			return v, v.OperationStatus, fmt.Errorf("There was an error retrieving the connection(port to port) information.")
+			return v, v.OperationStatus, operationError(client, v.OperationID, fmt.Errorf("There was an error retrieving the connection(port to port) information."))
 		}
 
 		return v, v.OperationStatus, nil
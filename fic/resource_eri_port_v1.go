@@ -1,13 +1,17 @@
 package fic
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strconv"
+	"strings"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/nttcom/go-fic"
 	"github.com/nttcom/go-fic/fic/eri/v1/ports"
@@ -15,12 +19,12 @@ import (
 
 func resourceEriPortV1() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceEriPortV1Create,
-		Read:   resourceEriPortV1Read,
-		Update: resourceEriPortV1Update,
-		Delete: resourceEriPortV1Delete,
+		CreateContext: resourceEriPortV1Create,
+		ReadContext:   resourceEriPortV1Read,
+		UpdateContext: resourceEriPortV1Update,
+		DeleteContext: resourceEriPortV1Delete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -121,11 +125,11 @@ func resourceEriPortV1() *schema.Resource {
 	}
 }
 
-func resourceEriPortV1Create(d *schema.ResourceData, meta interface{}) error {
+func resourceEriPortV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	client, err := config.eriV1Client(GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI client: %s", err)
+		return diag.Errorf("Error creating FIC ERI client: %s", err)
 	}
 
 	createOpts := &ports.CreateOpts{
@@ -143,7 +147,7 @@ func resourceEriPortV1Create(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
 	r, err := ports.Create(client, createOpts).Extract()
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI port: %s", err)
+		return diag.Errorf("Error creating FIC ERI port: %s", err)
 	}
 
 	d.SetId(r.ID)
@@ -162,9 +166,9 @@ func resourceEriPortV1Create(d *schema.ResourceData, meta interface{}) error {
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForStateContext(ctx)
 	if err != nil {
-		return fmt.Errorf(
+		return diag.Errorf(
 			"Error waiting for port (%s) to become ready: %s", r.ID, err)
 	}
 
@@ -174,7 +178,7 @@ func resourceEriPortV1Create(d *schema.ResourceData, meta interface{}) error {
 	if isActivated {
 		r, err = ports.Activate(client, r.ID).Extract()
 		if err != nil {
-			return fmt.Errorf("Error activating FIC ERI port: %s", err)
+			return attributeError("is_activated", "Error activating FIC ERI port", err)
 		}
 
 		log.Printf(
@@ -190,13 +194,13 @@ func resourceEriPortV1Create(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		log.Printf("[DEBUG] Waiting for port (%s) to become active", r.ID)
-		_, err = activateStateConf.WaitForState()
+		_, err = activateStateConf.WaitForStateContext(ctx)
 		if err != nil {
-			return fmt.Errorf("Error waiting for port (%s) to become active: %s", r.ID, err)
+			return diag.Errorf("Error waiting for port (%s) to become active: %s", r.ID, err)
 		}
 	}
 
-	return resourceEriPortV1Read(d, meta)
+	return resourceEriPortV1Read(ctx, d, meta)
 }
 
 func getVLANsForState(r *ports.Port) []map[string]interface{} {
@@ -213,16 +217,16 @@ func getVLANsForState(r *ports.Port) []map[string]interface{} {
 	return result
 }
 
-func resourceEriPortV1Read(d *schema.ResourceData, meta interface{}) error {
+func resourceEriPortV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	client, err := config.eriV1Client(GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI client: %s", err)
+		return diag.Errorf("Error creating FIC ERI client: %s", err)
 	}
 
 	r, err := ports.Get(client, d.Id()).Extract()
 	if err != nil {
-		return CheckDeleted(d, err, "port")
+		return diag.FromErr(CheckDeleted(d, err, "port"))
 	}
 
 	log.Printf("[DEBUG] Retrieved port %s: %+v", d.Id(), r)
@@ -236,20 +240,20 @@ func resourceEriPortV1Read(d *schema.ResourceData, meta interface{}) error {
 	d.Set("location", r.Location)
 	d.Set("vlans", getVLANsForState(r))
 
-	return nil
+	return operationStatusWarning("port", d.Id(), r.OperationStatus)
 }
 
-func resourceEriPortV1Update(d *schema.ResourceData, meta interface{}) error {
+func resourceEriPortV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	client, err := config.eriV1Client(GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI client: %s", err)
+		return diag.Errorf("Error creating FIC ERI client: %s", err)
 	}
 
 	if d.HasChange("is_activated") {
 		_, err := ports.Activate(client, d.Id()).Extract()
 		if err != nil {
-			return fmt.Errorf("Error activating FIC ERI port: %s", err)
+			return attributeError("is_activated", "Error activating FIC ERI port", err)
 		}
 
 		log.Printf(
@@ -265,24 +269,24 @@ func resourceEriPortV1Update(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		log.Printf("[DEBUG] Waiting for port (%s) to become active", d.Id())
-		_, err = activateStateConf.WaitForState()
+		_, err = activateStateConf.WaitForStateContext(ctx)
 		if err != nil {
-			return fmt.Errorf("Error waiting for port (%s) to become active: %s", d.Id(), err)
+			return diag.Errorf("Error waiting for port (%s) to become active: %s", d.Id(), err)
 		}
 	}
 
-	return resourceEriPortV1Read(d, meta)
+	return resourceEriPortV1Read(ctx, d, meta)
 }
 
-func resourceEriPortV1Delete(d *schema.ResourceData, meta interface{}) error {
+func resourceEriPortV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	client, err := config.eriV1Client(GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI client: %s", err)
+		return diag.Errorf("Error creating FIC ERI client: %s", err)
 	}
 
 	if err := ports.Delete(client, d.Id()).ExtractErr(); err != nil {
-		return CheckDeleted(d, err, "port")
+		return diag.FromErr(CheckDeleted(d, err, "port"))
 	}
 
 	log.Printf("[DEBUG] Waiting for port (%s) to delete", d.Id())
@@ -296,9 +300,9 @@ func resourceEriPortV1Delete(d *schema.ResourceData, meta interface{}) error {
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForStateContext(ctx)
 	if err != nil {
-		return fmt.Errorf(
+		return diag.Errorf(
 			"Error waiting for port (%s) to delete: %s",
 			d.Id(), err)
 	}
@@ -307,6 +311,39 @@ func resourceEriPortV1Delete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func getVLANRangesForState(r *ports.Port) ([]map[string]interface{}, error) {
+	return flattenVLANRanges(r.VLANRanges)
+}
+
+// flattenVLANRanges parses VLAN ranges in the "<start>-<end>" format
+// returned by the API into vlan_ranges blocks.
+func flattenVLANRanges(ranges []string) ([]map[string]interface{}, error) {
+	var result []map[string]interface{}
+	for _, vr := range ranges {
+		vlans := strings.Split(vr, "-")
+		if len(vlans) != 2 {
+			return nil, fmt.Errorf("vlan range is invalid format: %s", vr)
+		}
+
+		start, err := strconv.Atoi(vlans[0])
+		if err != nil {
+			return nil, fmt.Errorf("start of vlan range %s is not integer: %s", vr, err)
+		}
+
+		end, err := strconv.Atoi(vlans[1])
+		if err != nil {
+			return nil, fmt.Errorf("end of vlan range %s is not integer: %s", vr, err)
+		}
+
+		m := map[string]interface{}{
+			"start": start,
+			"end":   end,
+		}
+		result = append(result, m)
+	}
+	return result, nil
+}
+
 func getVLANRanges(d *schema.ResourceData) []string {
 	var result []string
 	rawRanges := d.Get("vlan_ranges").([]interface{})
@@ -330,7 +367,7 @@ func PortV1StateRefreshFunc(client *fic.ServiceClient, portID string) resource.S
 		}
 
 		if v.OperationStatus == "Error" {
-			return v, v.OperationStatus, fmt.Errorf("There was an error retrieving the port information.")
+			return v, v.OperationStatus, operationError(client, v.OperationID, fmt.Errorf("There was an error retrieving the port information."))
 		}
 
 		return v, v.OperationStatus, nil
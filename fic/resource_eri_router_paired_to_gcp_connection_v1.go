@@ -1,26 +1,47 @@
 package fic
 
 import (
+	"context"
 	"errors"
-	"fmt"
 	"regexp"
 	"time"
 
 	"github.com/nttcom/go-fic"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	connections "github.com/nttcom/go-fic/fic/eri/v1/router_paired_to_gcp_connections"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-func resourcePairedRouterToGCPConnection() *schema.Resource {
-	validInterconnects := []string{
-		"Equinix-TY2-1", "Equinix-TY2-2", "Equinix-TY2-3", "Equinix-TY2-4", "@Tokyo-CC2-1", "@Tokyo-CC2-2", "@Tokyo-CC2-3", "@Tokyo-CC2-4", "Equinix-OS1-1", "Equinix-OS1-2", "Equinix-OS1-3", "NTT-Dojima2-1", "NTT-Dojima2-2", "NTT-Dojima2-3",
+// gcpInterconnectsByArea lists the GCP interconnect points offered in each
+// area. FIC has no API to list them, so they are maintained here.
+var gcpInterconnectsByArea = map[string][]string{
+	"JPEAST": {
+		"Equinix-TY2-1", "Equinix-TY2-2", "Equinix-TY2-3", "Equinix-TY2-4", "@Tokyo-CC2-1", "@Tokyo-CC2-2", "@Tokyo-CC2-3", "@Tokyo-CC2-4",
+	},
+	"JPWEST": {
+		"Equinix-OS1-1", "Equinix-OS1-2", "Equinix-OS1-3", "NTT-Dojima2-1", "NTT-Dojima2-2", "NTT-Dojima2-3",
+	},
+}
+
+// gcpPairingKeyRegexp matches the pairing key of a GCP Partner Interconnect
+// VLAN attachment, see
+// https://cloud.google.com/network-connectivity/docs/interconnect/concepts/terminology#pairingkey
+var gcpPairingKeyRegexp = regexp.MustCompile(`^[a-fA-F\d]{8}(-[a-fA-F\d]{4}){3}-[a-fA-F\d]{12}/[a-zA-Z\d-]*/[1,2]$`)
+
+// gcpInterconnectSchema returns the schema of a GCP interconnect point and
+// the pairing key of the VLAN attachment connected to it.
+func gcpInterconnectSchema() *schema.Resource {
+	var validInterconnects []string
+	for _, area := range []string{"JPEAST", "JPWEST"} {
+		validInterconnects = append(validInterconnects, gcpInterconnectsByArea[area]...)
 	}
-	interconnectSchema := &schema.Resource{
+
+	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"interconnect": {
 				Type:         schema.TypeString,
@@ -32,16 +53,20 @@ func resourcePairedRouterToGCPConnection() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-fA-F\d]{8}(-[a-fA-F\d]{4}){3}-[a-fA-F\d]{12}/[a-zA-Z\d-]*/[1,2]$`), "see https://cloud.google.com/network-connectivity/docs/interconnect/concepts/terminology?_ga=2.264742223.-1966628098.1560150466#pairingkey"),
+				ValidateFunc: validation.StringMatch(gcpPairingKeyRegexp, "see https://cloud.google.com/network-connectivity/docs/interconnect/concepts/terminology?_ga=2.264742223.-1966628098.1560150466#pairingkey"),
 			},
 		},
 	}
+}
+
+func resourcePairedRouterToGCPConnection() *schema.Resource {
+	interconnectSchema := gcpInterconnectSchema()
 
 	return &schema.Resource{
-		Create: resourcePairedRouterToGCPConnectionCreate,
-		Read:   resourcePairedRouterToGCPConnectionRead,
-		Update: resourcePairedRouterToGCPConnectionUpdate,
-		Delete: resourcePairedRouterToGCPConnectionDelete,
+		CreateContext: resourcePairedRouterToGCPConnectionCreate,
+		ReadContext:   resourcePairedRouterToGCPConnectionRead,
+		UpdateContext: resourcePairedRouterToGCPConnectionUpdate,
+		DeleteContext: resourcePairedRouterToGCPConnectionDelete,
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -50,7 +75,7 @@ func resourcePairedRouterToGCPConnection() *schema.Resource {
 		},
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -171,11 +196,15 @@ func resourcePairedRouterToGCPConnection() *schema.Resource {
 	}
 }
 
-func resourcePairedRouterToGCPConnectionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourcePairedRouterToGCPConnectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	routerID := d.Get("source.0.router_id").(string)
+	osMutexKV.Lock(routerID)
+	defer osMutexKV.Unlock(routerID)
+
 	config := meta.(*Config)
 	client, err := config.eriV1Client(GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("error creating FIC client: %w", err)
+		return diag.Errorf("error creating FIC client: %s", err)
 	}
 
 	opts := &connections.CreateOpts{
@@ -187,7 +216,7 @@ func resourcePairedRouterToGCPConnectionCreate(d *schema.ResourceData, meta inte
 
 	conn, err := connections.Create(client, opts).Extract()
 	if err != nil {
-		return fmt.Errorf("error creating FIC paired router to GCP connection: %w", err)
+		return diag.Errorf("error creating FIC paired router to GCP connection: %s", err)
 	}
 
 	d.SetId(conn.ID)
@@ -201,13 +230,13 @@ func resourcePairedRouterToGCPConnectionCreate(d *schema.ResourceData, meta inte
 		MinTimeout: 5 * time.Second,
 	}
 
-	if _, err = stateConf.WaitForState(); err != nil {
-		return fmt.Errorf("error waiting for connection (%s) to become ready: %w", conn.ID, err)
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for connection (%s) to become ready: %s", conn.ID, err)
 	}
 
 	d.Set("operation_id", conn.OperationID)
 
-	return resourcePairedRouterToGCPConnectionRead(d, meta)
+	return resourcePairedRouterToGCPConnectionRead(ctx, d, meta)
 }
 
 func routerToGCPConnectionRefresh(c *fic.ServiceClient, id string) func() (interface{}, string, error) {
@@ -221,20 +250,24 @@ func routerToGCPConnectionRefresh(c *fic.ServiceClient, id string) func() (inter
 			return nil, "", err
 		}
 
+		if conn.OperationStatus == "Error" {
+			return conn, conn.OperationStatus, operationError(c, conn.OperationID, errors.New("there was an error retrieving the paired router to GCP connection information"))
+		}
+
 		return conn, conn.OperationStatus, nil
 	}
 }
 
-func resourcePairedRouterToGCPConnectionRead(d *schema.ResourceData, meta interface{}) error {
+func resourcePairedRouterToGCPConnectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	client, err := config.eriV1Client(GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("error creating FIC client: %w", err)
+		return diag.Errorf("error creating FIC client: %s", err)
 	}
 
 	conn, err := connections.Get(client, d.Id()).Extract()
 	if err != nil {
-		return CheckDeleted(d, err, "error getting FIC paired router to GCP connection")
+		return diag.FromErr(CheckDeleted(d, err, "error getting FIC paired router to GCP connection"))
 	}
 
 	d.Set("name", conn.Name)
@@ -248,14 +281,18 @@ func resourcePairedRouterToGCPConnectionRead(d *schema.ResourceData, meta interf
 	d.Set("primary_connected_network_address", conn.PrimaryConnectedNetworkAddress)
 	d.Set("secondary_connected_network_address", conn.SecondaryConnectedNetworkAddress)
 
-	return nil
+	return operationStatusWarning("connection", d.Id(), conn.OperationStatus)
 }
 
-func resourcePairedRouterToGCPConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourcePairedRouterToGCPConnectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	routerID := d.Get("source.0.router_id").(string)
+	osMutexKV.Lock(routerID)
+	defer osMutexKV.Unlock(routerID)
+
 	config := meta.(*Config)
 	client, err := config.eriV1Client(GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("error creating FIC client: %w", err)
+		return diag.Errorf("error creating FIC client: %s", err)
 	}
 
 	source := expandSource(d.Get("source").([]interface{}))
@@ -270,7 +307,7 @@ func resourcePairedRouterToGCPConnectionUpdate(d *schema.ResourceData, meta inte
 
 	conn, err := connections.Update(client, d.Id(), opts).Extract()
 	if err != nil {
-		return fmt.Errorf("error updating FIC paired router to GCP connection: %w", err)
+		return diag.Errorf("error updating FIC paired router to GCP connection: %s", err)
 	}
 
 	stateConf := &resource.StateChangeConf{
@@ -282,23 +319,27 @@ func resourcePairedRouterToGCPConnectionUpdate(d *schema.ResourceData, meta inte
 		MinTimeout: 5 * time.Second,
 	}
 
-	if _, err = stateConf.WaitForState(); err != nil {
-		return fmt.Errorf("error waiting for connection (%s) to become ready: %w", conn.ID, err)
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for connection (%s) to become ready: %s", conn.ID, err)
 	}
 
 	d.Set("operation_id", conn.OperationID)
 
-	return resourcePairedRouterToGCPConnectionRead(d, meta)
+	return resourcePairedRouterToGCPConnectionRead(ctx, d, meta)
 }
 
-func resourcePairedRouterToGCPConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourcePairedRouterToGCPConnectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	routerID := d.Get("source.0.router_id").(string)
+	osMutexKV.Lock(routerID)
+	defer osMutexKV.Unlock(routerID)
+
 	config := meta.(*Config)
 	client, err := config.eriV1Client(GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("error creating FIC client: %w", err)
+		return diag.Errorf("error creating FIC client: %s", err)
 	}
 
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		if err := connections.Delete(client, d.Id()).ExtractErr(); err != nil {
 			var e404 fic.ErrDefault404
 			if errors.As(err, &e404) {
@@ -317,7 +358,7 @@ func resourcePairedRouterToGCPConnectionDelete(d *schema.ResourceData, meta inte
 	})
 
 	if err != nil {
-		return fmt.Errorf("error deleting FIC paired router to GCP connection: %w", err)
+		return diag.Errorf("error deleting FIC paired router to GCP connection: %s", err)
 	}
 
 	d.SetId("")
@@ -5,15 +5,15 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 
 	"github.com/nttcom/go-fic"
 
 	connections "github.com/nttcom/go-fic/fic/eri/v1/router_paired_to_gcp_connections"
 
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
 func TestAccPairedRouterToGCPConnection_basic(t *testing.T) {
@@ -22,10 +22,11 @@ func TestAccPairedRouterToGCPConnection_basic(t *testing.T) {
 	resourceName := "fic_eri_router_paired_to_gcp_connection_v1.test"
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:      func() { testAccPreCheckGCPConnection(t) },
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckPairedRouterToGCPConnectionDestroy,
-		IDRefreshName: resourceName,
+		PreCheck:          func() { testAccPreCheckGCPConnection(t) },
+		Providers:         testAccProviders,
+		ExternalProviders: testAccExternalProviders,
+		CheckDestroy:      testAccCheckPairedRouterToGCPConnectionDestroy,
+		IDRefreshName:     resourceName,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccPairedRouterToGCPConnectionConfig(rName, "10M", "noRoute", "privateRoute", 10),
@@ -1,14 +1,16 @@
 package fic
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/nttcom/go-fic"
 	connections "github.com/nttcom/go-fic/fic/eri/v1/router_paired_to_port_connections"
@@ -16,12 +18,12 @@ import (
 
 func resourceEriRouterPairedToPortConnectionV1() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceEriRouterPairedToPortConnectionV1Create,
-		Read:   resourceEriRouterPairedToPortConnectionV1Read,
-		Update: resourceEriRouterPairedToPortConnectionV1Update,
-		Delete: resourceEriRouterPairedToPortConnectionV1Delete,
+		CreateContext: resourceEriRouterPairedToPortConnectionV1Create,
+		ReadContext:   resourceEriRouterPairedToPortConnectionV1Read,
+		UpdateContext: resourceEriRouterPairedToPortConnectionV1Update,
+		DeleteContext: resourceEriRouterPairedToPortConnectionV1Delete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -148,6 +150,16 @@ func resourceEriRouterPairedToPortConnectionV1() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+
+			"operation_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"operation_status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -282,11 +294,15 @@ func getDestinationOfRouterPairedToPortConnection(d *schema.ResourceData) connec
 	return destination
 }
 
-func resourceEriRouterPairedToPortConnectionV1Create(d *schema.ResourceData, meta interface{}) error {
+func resourceEriRouterPairedToPortConnectionV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	routerID := d.Get("source_router_id").(string)
+	osMutexKV.Lock(routerID)
+	defer osMutexKV.Unlock(routerID)
+
 	config := meta.(*Config)
 	client, err := config.eriV1Client(GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI client: %s", err)
+		return diag.Errorf("Error creating FIC ERI client: %s", err)
 	}
 
 	createOpts := &connections.CreateOpts{
@@ -299,7 +315,7 @@ func resourceEriRouterPairedToPortConnectionV1Create(d *schema.ResourceData, met
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
 	r, err := connections.Create(client, createOpts).Extract()
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI connection(router to port): %s", err)
+		return diag.Errorf("Error creating FIC ERI connection(router to port): %s", err)
 	}
 
 	d.SetId(r.ID)
@@ -318,13 +334,13 @@ func resourceEriRouterPairedToPortConnectionV1Create(d *schema.ResourceData, met
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForStateContext(ctx)
 	if err != nil {
-		return fmt.Errorf(
+		return diag.Errorf(
 			"Error waiting for connection (%s) to become ready: %s", r.ID, err)
 	}
 
-	return resourceEriRouterPairedToPortConnectionV1Read(d, meta)
+	return resourceEriRouterPairedToPortConnectionV1Read(ctx, d, meta)
 }
 
 func getSourceInformationOfRouterPairedToPortConnectionForState(r *connections.Connection) []map[string]interface{} {
@@ -363,16 +379,16 @@ func getDestinationOfRouterPairedToPortConnectionInformationForState(r *connecti
 	}
 }
 
-func resourceEriRouterPairedToPortConnectionV1Read(d *schema.ResourceData, meta interface{}) error {
+func resourceEriRouterPairedToPortConnectionV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	client, err := config.eriV1Client(GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI client: %s", err)
+		return diag.Errorf("Error creating FIC ERI client: %s", err)
 	}
 
 	r, err := connections.Get(client, d.Id()).Extract()
 	if err != nil {
-		return CheckDeleted(d, err, "connection")
+		return diag.FromErr(CheckDeleted(d, err, "connection"))
 	}
 
 	log.Printf("[DEBUG] Retrieved connection %s: %+v", d.Id(), r)
@@ -393,14 +409,21 @@ func resourceEriRouterPairedToPortConnectionV1Read(d *schema.ResourceData, meta
 	d.Set("tenant_id", r.TenantID)
 	d.Set("area", r.Area)
 
-	return nil
+	d.Set("operation_id", r.OperationID)
+	d.Set("operation_status", r.OperationStatus)
+
+	return operationStatusWarning("connection", d.Id(), r.OperationStatus)
 }
 
-func resourceEriRouterPairedToPortConnectionV1Update(d *schema.ResourceData, meta interface{}) error {
+func resourceEriRouterPairedToPortConnectionV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	routerID := d.Get("source_router_id").(string)
+	osMutexKV.Lock(routerID)
+	defer osMutexKV.Unlock(routerID)
+
 	config := meta.(*Config)
 	client, err := config.eriV1Client(GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI client: %s", err)
+		return diag.Errorf("Error creating FIC ERI client: %s", err)
 	}
 
 	if d.HasChange("source_information") {
@@ -409,7 +432,7 @@ func resourceEriRouterPairedToPortConnectionV1Update(d *schema.ResourceData, met
 		}
 		_, err := connections.Update(client, d.Id(), updateOpts).Extract()
 		if err != nil {
-			return fmt.Errorf("Error activating FIC ERI connection: %s", err)
+			return attributeError("source_information", "Error updating FIC ERI connection", err)
 		}
 
 		log.Printf(
@@ -425,24 +448,28 @@ func resourceEriRouterPairedToPortConnectionV1Update(d *schema.ResourceData, met
 		}
 
 		log.Printf("[DEBUG] Waiting for connection (%s) to become complete", d.Id())
-		_, err = stateConf.WaitForState()
+		_, err = stateConf.WaitForStateContext(ctx)
 		if err != nil {
-			return fmt.Errorf("Error waiting for connection (%s) to become complete: %s", d.Id(), err)
+			return diag.Errorf("Error waiting for connection (%s) to become complete: %s", d.Id(), err)
 		}
 	}
 
-	return resourceEriRouterPairedToPortConnectionV1Read(d, meta)
+	return resourceEriRouterPairedToPortConnectionV1Read(ctx, d, meta)
 }
 
-func resourceEriRouterPairedToPortConnectionV1Delete(d *schema.ResourceData, meta interface{}) error {
+func resourceEriRouterPairedToPortConnectionV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	routerID := d.Get("source_router_id").(string)
+	osMutexKV.Lock(routerID)
+	defer osMutexKV.Unlock(routerID)
+
 	config := meta.(*Config)
 	client, err := config.eriV1Client(GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI client: %s", err)
+		return diag.Errorf("Error creating FIC ERI client: %s", err)
 	}
 
 	if err := connections.Delete(client, d.Id()).ExtractErr(); err != nil {
-		return CheckDeleted(d, err, "connection")
+		return diag.FromErr(CheckDeleted(d, err, "connection"))
 	}
 
 	log.Printf("[DEBUG] Waiting for connection (%s) to delete", d.Id())
@@ -456,9 +483,9 @@ func resourceEriRouterPairedToPortConnectionV1Delete(d *schema.ResourceData, met
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForStateContext(ctx)
 	if err != nil {
-		return fmt.Errorf(
+		return diag.Errorf(
 			"Error waiting for connection (%s) to delete: %s",
 			d.Id(), err)
 	}
@@ -478,7 +505,7 @@ func RouterToPortConnectionV1StateRefreshFunc(client *fic.ServiceClient, connect
 		}
 
 		if v.OperationStatus == "Error" {
-			return v, v.OperationStatus, fmt.Errorf("There was an error retrieving the connection(router to port) information.")
+			return v, v.OperationStatus, operationError(client, v.OperationID, fmt.Errorf("There was an error retrieving the connection(router to port) information."))
 		}
 
 		return v, v.OperationStatus, nil
@@ -0,0 +1,311 @@
+package fic
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nttcom/go-fic"
+	connections "github.com/nttcom/go-fic/fic/eri/v1/router_single_to_gcp_connections"
+)
+
+func resourceSingleRouterToGCPConnection() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSingleRouterToGCPConnectionCreate,
+		ReadContext:   resourceSingleRouterToGCPConnectionRead,
+		UpdateContext: resourceSingleRouterToGCPConnectionUpdate,
+		DeleteContext: resourceSingleRouterToGCPConnectionDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[\w&()-]{1,64}$`), "must be less than 64 characters in half-width alphanumeric characters and some symbols &()-_"),
+			},
+			"bandwidth": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"10M", "50M", "100M", "200M", "300M", "400M", "500M", "1G", "2G", "5G", "10G"}, false),
+			},
+			"source": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"router_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^F\d{12}$`), "must be a F + 12-digit number"),
+						},
+						"group_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"group_1", "group_2", "group_3", "group_4", "group_5", "group_6", "group_7", "group_8"}, false),
+						},
+						"route_filter": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"in": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice([]string{"fullRoute", "noRoute"}, false),
+									},
+									"out": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice([]string{"fullRoute", "fullRouteWithDefaultRoute", "defaultRoute", "privateRoute", "noRoute"}, false),
+									},
+								},
+							},
+						},
+						"as_path_prepend_in": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "OFF",
+							ValidateFunc: validation.StringInSlice([]string{"OFF", "1", "2", "3", "4", "5"}, false),
+						},
+						"as_path_prepend_out": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "OFF",
+							ValidateFunc: validation.StringInSlice([]string{"OFF", "1", "2", "3", "4", "5"}, false),
+						},
+					},
+				},
+			},
+			"destination": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"primary": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem:     gcpInterconnectSchema(),
+						},
+						"qos_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"redundant": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"area": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"operation_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"operation_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"primary_connected_network_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSingleRouterToGCPConnectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	routerID := d.Get("source.0.router_id").(string)
+	osMutexKV.Lock(routerID)
+	defer osMutexKV.Unlock(routerID)
+
+	config := meta.(*Config)
+	client, err := config.eriV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("error creating FIC client: %s", err)
+	}
+
+	opts := &connections.CreateOpts{
+		Name:        d.Get("name").(string),
+		Source:      expandSingleRouterToGCPSource(d.Get("source").([]interface{})),
+		Destination: expandSingleRouterToGCPDestination(d.Get("destination").([]interface{})),
+		Bandwidth:   d.Get("bandwidth").(string),
+	}
+
+	conn, err := connections.Create(client, opts).Extract()
+	if err != nil {
+		return diag.Errorf("error creating FIC single router to GCP connection: %s", err)
+	}
+
+	d.SetId(conn.ID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"Processing"},
+		Target:     []string{"Completed"},
+		Refresh:    singleRouterToGCPConnectionRefresh(client, conn.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for connection (%s) to become ready: %s", conn.ID, err)
+	}
+
+	d.Set("operation_id", conn.OperationID)
+
+	return resourceSingleRouterToGCPConnectionRead(ctx, d, meta)
+}
+
+func singleRouterToGCPConnectionRefresh(c *fic.ServiceClient, id string) func() (interface{}, string, error) {
+	return func() (interface{}, string, error) {
+		conn, err := connections.Get(c, id).Extract()
+		if err != nil {
+			var e fic.ErrDefault404
+			if errors.As(err, &e) {
+				return nil, "", nil
+			}
+			return nil, "", err
+		}
+
+		if conn.OperationStatus == "Error" {
+			return conn, conn.OperationStatus, operationError(c, conn.OperationID, errors.New("there was an error retrieving the single router to GCP connection information"))
+		}
+
+		return conn, conn.OperationStatus, nil
+	}
+}
+
+func resourceSingleRouterToGCPConnectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	client, err := config.eriV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("error creating FIC client: %s", err)
+	}
+
+	conn, err := connections.Get(client, d.Id()).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "error getting FIC single router to GCP connection"))
+	}
+
+	d.Set("name", conn.Name)
+	d.Set("bandwidth", conn.Bandwidth)
+	d.Set("source", flattenSingleRouterToGCPSource(conn.Source))
+	d.Set("destination", flattenSingleRouterToGCPDestination(conn.Destination))
+	d.Set("redundant", conn.Redundant)
+	d.Set("tenant_id", conn.TenantID)
+	d.Set("area", conn.Area)
+	d.Set("operation_status", conn.OperationStatus)
+	d.Set("primary_connected_network_address", conn.PrimaryConnectedNetworkAddress)
+
+	return operationStatusWarning("connection", d.Id(), conn.OperationStatus)
+}
+
+func resourceSingleRouterToGCPConnectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	routerID := d.Get("source.0.router_id").(string)
+	osMutexKV.Lock(routerID)
+	defer osMutexKV.Unlock(routerID)
+
+	config := meta.(*Config)
+	client, err := config.eriV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("error creating FIC client: %s", err)
+	}
+
+	source := expandSingleRouterToGCPSource(d.Get("source").([]interface{}))
+	opts := connections.UpdateOpts{
+		Source: connections.SourceForUpdate{
+			RouteFilter: source.RouteFilter,
+			Primary:     source.Primary,
+		},
+		Bandwidth: d.Get("bandwidth").(string),
+	}
+
+	conn, err := connections.Update(client, d.Id(), opts).Extract()
+	if err != nil {
+		return attributeError(changedAttribute(d, "source", "bandwidth"), "error updating FIC single router to GCP connection", err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"Processing"},
+		Target:     []string{"Completed"},
+		Refresh:    singleRouterToGCPConnectionRefresh(client, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for connection (%s) to become ready: %s", d.Id(), err)
+	}
+
+	d.Set("operation_id", conn.OperationID)
+
+	return resourceSingleRouterToGCPConnectionRead(ctx, d, meta)
+}
+
+func resourceSingleRouterToGCPConnectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	routerID := d.Get("source.0.router_id").(string)
+	osMutexKV.Lock(routerID)
+	defer osMutexKV.Unlock(routerID)
+
+	config := meta.(*Config)
+	client, err := config.eriV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("error creating FIC client: %s", err)
+	}
+
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		if err := connections.Delete(client, d.Id()).ExtractErr(); err != nil {
+			var e404 fic.ErrDefault404
+			if errors.As(err, &e404) {
+				return nil
+			}
+
+			var e409 fic.ErrDefault409
+			if errors.As(err, &e409) {
+				return resource.RetryableError(err)
+			}
+
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return diag.Errorf("error deleting FIC single router to GCP connection: %s", err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
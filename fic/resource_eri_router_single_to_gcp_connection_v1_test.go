@@ -0,0 +1,215 @@
+package fic
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+
+	"github.com/nttcom/go-fic"
+
+	connections "github.com/nttcom/go-fic/fic/eri/v1/router_single_to_gcp_connections"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSingleRouterToGCPConnection_basic(t *testing.T) {
+	var connection connections.Connection
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "fic_eri_router_single_to_gcp_connection_v1.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckGCPConnection(t) },
+		Providers:         testAccProviders,
+		ExternalProviders: testAccExternalProviders,
+		CheckDestroy:      testAccCheckSingleRouterToGCPConnectionDestroy,
+		IDRefreshName:     resourceName,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSingleRouterToGCPConnectionConfig(rName, "10M", "noRoute", "privateRoute", "OFF"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSingleRouterToGCPConnectionExists(resourceName, &connection),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth", "10M"),
+					resource.TestCheckResourceAttrSet(resourceName, "source.0.router_id"),
+					resource.TestCheckResourceAttr(resourceName, "source.0.group_name", "group_1"),
+					resource.TestCheckResourceAttr(resourceName, "source.0.route_filter.0.in", "noRoute"),
+					resource.TestCheckResourceAttr(resourceName, "source.0.route_filter.0.out", "privateRoute"),
+					resource.TestCheckResourceAttr(resourceName, "source.0.as_path_prepend_out", "OFF"),
+					resource.TestCheckResourceAttr(resourceName, "destination.0.primary.0.interconnect", "Equinix-TY2-2"),
+					resource.TestCheckResourceAttrSet(resourceName, "destination.0.primary.0.pairing_key"),
+					resource.TestCheckResourceAttr(resourceName, "destination.0.qos_type", "guarantee"),
+					resource.TestCheckResourceAttr(resourceName, "redundant", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "tenant_id"),
+					resource.TestCheckResourceAttr(resourceName, "area", "JPEAST"),
+					resource.TestCheckResourceAttrSet(resourceName, "operation_id"),
+					resource.TestCheckResourceAttr(resourceName, "operation_status", "Completed"),
+					resource.TestCheckResourceAttrSet(resourceName, "primary_connected_network_address"),
+				),
+			},
+			{
+				Config: testAccSingleRouterToGCPConnectionConfig(rName, "50M", "fullRoute", "defaultRoute", "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSingleRouterToGCPConnectionExists(resourceName, &connection),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth", "50M"),
+					resource.TestCheckResourceAttr(resourceName, "source.0.route_filter.0.in", "fullRoute"),
+					resource.TestCheckResourceAttr(resourceName, "source.0.route_filter.0.out", "defaultRoute"),
+					resource.TestCheckResourceAttr(resourceName, "source.0.as_path_prepend_out", "2"),
+					resource.TestCheckResourceAttr(resourceName, "operation_status", "Completed"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"operation_id",
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckSingleRouterToGCPConnectionExists(resourceName string, connection *connections.Connection) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("id is not set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		client, err := config.eriV1Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating FIC client: %w", err)
+		}
+
+		actual, err := connections.Get(client, rs.Primary.ID).Extract()
+		if err != nil {
+			return fmt.Errorf("error getting FIC single router to GCP connection: %w", err)
+		}
+
+		*connection = *actual
+
+		return nil
+	}
+}
+
+func testAccCheckSingleRouterToGCPConnectionDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	client, err := config.eriV1Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating FIC client: %w", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "fic_eri_router_single_to_gcp_connection_v1" {
+			continue
+		}
+
+		if result := connections.Get(client, rs.Primary.ID); result.Err != nil {
+			var e fic.ErrDefault404
+			if errors.As(result.Err, &e) {
+				return nil
+			}
+			return fmt.Errorf("error getting FIC single router to GCP connection: %w", result.Err)
+		}
+
+		return fmt.Errorf("connection (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccSingleRouterToGCPConnectionConfig(rName, bandwidth, routeFilterIn, routeFilterOut, asPathPrependOut string) string {
+	return fmt.Sprintf(`
+resource "google_compute_router" "test" {
+	name = %[1]q
+	network = "default"
+	bgp {
+		asn = 16550
+	}
+}
+
+resource "google_compute_interconnect_attachment" "test" {
+	name = %[1]q
+	router = google_compute_router.test.id
+	type = "PARTNER"
+	edge_availability_domain = "AVAILABILITY_DOMAIN_1"
+}
+
+resource "fic_eri_router_v1" "test" {
+	name = %[1]q
+	area = "JPEAST"
+	user_ip_address = "10.0.0.0/27"
+	redundant = false
+}
+
+resource "fic_eri_router_single_to_gcp_connection_v1" "test" {
+	name = %[1]q
+	bandwidth = %[2]q
+	source {
+		router_id = fic_eri_router_v1.test.id
+		group_name = "group_1"
+		route_filter {
+			in = %[3]q
+			out = %[4]q
+		}
+		as_path_prepend_out = %[5]q
+	}
+	destination {
+		primary {
+			interconnect = "Equinix-TY2-2"
+			pairing_key = google_compute_interconnect_attachment.test.pairing_key
+		}
+	}
+}
+`, rName, bandwidth, routeFilterIn, routeFilterOut, asPathPrependOut)
+}
+
+func TestASPathPrepend(t *testing.T) {
+	for _, v := range []string{"OFF", "1", "5"} {
+		if actual := flattenASPathPrepend(expandASPathPrepend(v)); actual != v {
+			t.Errorf("Expected %s to round trip, got %s", v, actual)
+		}
+	}
+
+	if v := expandASPathPrepend("OFF"); *v != nil {
+		t.Errorf("Expected OFF to be sent as null, got %v", *v)
+	}
+
+	// Numbers decoded from the API response are float64.
+	var decoded interface{} = float64(3)
+	if actual := flattenASPathPrepend(&decoded); actual != "3" {
+		t.Errorf("Expected 3, got %s", actual)
+	}
+
+	if actual := flattenASPathPrepend(nil); actual != "OFF" {
+		t.Errorf("Expected OFF, got %s", actual)
+	}
+}
+
+func TestGCPInterconnectSchemaPairingKey(t *testing.T) {
+	validate := gcpInterconnectSchema().Schema["pairing_key"].ValidateFunc
+
+	cases := map[string]bool{
+		"7e51371e-72a3-40b5-b844-2e3efefaee59/asia-northeast1/1": true,
+		"7e51371e-72a3-40b5-b844-2e3efefaee59/asia-northeast1/2": true,
+		"7e51371e-72a3-40b5-b844-2e3efefaee59/asia-northeast1/3": false,
+		"7e51371e-72a3-40b5-b844/asia-northeast1/1":              false,
+		"": false,
+	}
+
+	for key, valid := range cases {
+		_, errs := validate(key, "pairing_key")
+		if (len(errs) == 0) != valid {
+			t.Errorf("%q: expected valid to be %t, got %v", key, valid, errs)
+		}
+	}
+}
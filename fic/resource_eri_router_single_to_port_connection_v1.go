@@ -1,26 +1,27 @@
 package fic
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"strconv"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	connections "github.com/nttcom/go-fic/fic/eri/v1/router_single_to_port_connections"
 )
 
 func resourceEriRouterSingleToPortConnectionV1() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceEriRouterSingleToPortConnectionV1Create,
-		Read:   resourceEriRouterSingleToPortConnectionV1Read,
-		Update: resourceEriRouterSingleToPortConnectionV1Update,
-		Delete: resourceEriRouterSingleToPortConnectionV1Delete,
+		CreateContext: resourceEriRouterSingleToPortConnectionV1Create,
+		ReadContext:   resourceEriRouterSingleToPortConnectionV1Read,
+		UpdateContext: resourceEriRouterSingleToPortConnectionV1Update,
+		DeleteContext: resourceEriRouterSingleToPortConnectionV1Delete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -147,6 +148,16 @@ func resourceEriRouterSingleToPortConnectionV1() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+
+			"operation_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"operation_status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -281,11 +292,15 @@ func getDestinationOfRouterSingleToPortConnection(d *schema.ResourceData) connec
 	return destination
 }
 
-func resourceEriRouterSingleToPortConnectionV1Create(d *schema.ResourceData, meta interface{}) error {
+func resourceEriRouterSingleToPortConnectionV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	routerID := d.Get("source_router_id").(string)
+	osMutexKV.Lock(routerID)
+	defer osMutexKV.Unlock(routerID)
+
 	config := meta.(*Config)
 	client, err := config.eriV1Client(GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI client: %s", err)
+		return diag.Errorf("Error creating FIC ERI client: %s", err)
 	}
 
 	createOpts := &connections.CreateOpts{
@@ -298,7 +313,7 @@ func resourceEriRouterSingleToPortConnectionV1Create(d *schema.ResourceData, met
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
 	r, err := connections.Create(client, createOpts).Extract()
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI connection(router to port): %s", err)
+		return diag.Errorf("Error creating FIC ERI connection(router to port): %s", err)
 	}
 
 	d.SetId(r.ID)
@@ -317,13 +332,13 @@ func resourceEriRouterSingleToPortConnectionV1Create(d *schema.ResourceData, met
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForStateContext(ctx)
 	if err != nil {
-		return fmt.Errorf(
+		return diag.Errorf(
 			"Error waiting for connection (%s) to become ready: %s", r.ID, err)
 	}
 
-	return resourceEriRouterSingleToPortConnectionV1Read(d, meta)
+	return resourceEriRouterSingleToPortConnectionV1Read(ctx, d, meta)
 }
 
 func getSourceInformationOfRouterSingleToPortConnectionForState(r *connections.Connection) []map[string]interface{} {
@@ -362,16 +377,16 @@ func getDestinationOfRouterSingleToPortConnectionInformationForState(r *connecti
 	}
 }
 
-func resourceEriRouterSingleToPortConnectionV1Read(d *schema.ResourceData, meta interface{}) error {
+func resourceEriRouterSingleToPortConnectionV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	client, err := config.eriV1Client(GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI client: %s", err)
+		return diag.Errorf("Error creating FIC ERI client: %s", err)
 	}
 
 	r, err := connections.Get(client, d.Id()).Extract()
 	if err != nil {
-		return CheckDeleted(d, err, "connection")
+		return diag.FromErr(CheckDeleted(d, err, "connection"))
 	}
 
 	log.Printf("[DEBUG] Retrieved connection %s: %+v", d.Id(), r)
@@ -392,14 +407,21 @@ func resourceEriRouterSingleToPortConnectionV1Read(d *schema.ResourceData, meta
 	d.Set("tenant_id", r.TenantID)
 	d.Set("area", r.Area)
 
-	return nil
+	d.Set("operation_id", r.OperationID)
+	d.Set("operation_status", r.OperationStatus)
+
+	return operationStatusWarning("connection", d.Id(), r.OperationStatus)
 }
 
-func resourceEriRouterSingleToPortConnectionV1Update(d *schema.ResourceData, meta interface{}) error {
+func resourceEriRouterSingleToPortConnectionV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	routerID := d.Get("source_router_id").(string)
+	osMutexKV.Lock(routerID)
+	defer osMutexKV.Unlock(routerID)
+
 	config := meta.(*Config)
 	client, err := config.eriV1Client(GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI client: %s", err)
+		return diag.Errorf("Error creating FIC ERI client: %s", err)
 	}
 
 	if d.HasChange("source_information") {
@@ -408,7 +430,7 @@ func resourceEriRouterSingleToPortConnectionV1Update(d *schema.ResourceData, met
 		}
 		_, err := connections.Update(client, d.Id(), updateOpts).Extract()
 		if err != nil {
-			return fmt.Errorf("Error activating FIC ERI connection: %s", err)
+			return attributeError("source_information", "Error updating FIC ERI connection", err)
 		}
 
 		log.Printf(
@@ -424,24 +446,28 @@ func resourceEriRouterSingleToPortConnectionV1Update(d *schema.ResourceData, met
 		}
 
 		log.Printf("[DEBUG] Waiting for connection (%s) to become complete", d.Id())
-		_, err = stateConf.WaitForState()
+		_, err = stateConf.WaitForStateContext(ctx)
 		if err != nil {
-			return fmt.Errorf("Error waiting for connection (%s) to become complete: %s", d.Id(), err)
+			return diag.Errorf("Error waiting for connection (%s) to become complete: %s", d.Id(), err)
 		}
 	}
 
-	return resourceEriRouterSingleToPortConnectionV1Read(d, meta)
+	return resourceEriRouterSingleToPortConnectionV1Read(ctx, d, meta)
 }
 
-func resourceEriRouterSingleToPortConnectionV1Delete(d *schema.ResourceData, meta interface{}) error {
+func resourceEriRouterSingleToPortConnectionV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	routerID := d.Get("source_router_id").(string)
+	osMutexKV.Lock(routerID)
+	defer osMutexKV.Unlock(routerID)
+
 	config := meta.(*Config)
 	client, err := config.eriV1Client(GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI client: %s", err)
+		return diag.Errorf("Error creating FIC ERI client: %s", err)
 	}
 
 	if err := connections.Delete(client, d.Id()).ExtractErr(); err != nil {
-		return CheckDeleted(d, err, "connection")
+		return diag.FromErr(CheckDeleted(d, err, "connection"))
 	}
 
 	log.Printf("[DEBUG] Waiting for connection (%s) to delete", d.Id())
@@ -455,9 +481,9 @@ func resourceEriRouterSingleToPortConnectionV1Delete(d *schema.ResourceData, met
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForStateContext(ctx)
 	if err != nil {
-		return fmt.Errorf(
+		return diag.Errorf(
 			"Error waiting for connection (%s) to delete: %s",
 			d.Id(), err)
 	}
@@ -0,0 +1,382 @@
+package fic
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nttcom/go-fic"
+	connections "github.com/nttcom/go-fic/fic/eri/v1/router_paired_to_aws_connections"
+)
+
+// awsAccountIDRegexp matches the 12 digit ID of an AWS account.
+var awsAccountIDRegexp = regexp.MustCompile(`^[0-9]{12}$`)
+
+// awsConnectionBandwidths lists the capacities AWS offers for
+// hosted connections, which every connection to AWS is.
+var awsConnectionBandwidths = []string{
+	"50M", "100M", "200M", "300M", "400M", "500M",
+	"1G", "2G", "5G", "10G",
+}
+
+func resourceEriRouterToAWSConnectionV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceEriRouterToAWSConnectionV1Create,
+		ReadContext:   resourceEriRouterToAWSConnectionV1Read,
+		UpdateContext: resourceEriRouterToAWSConnectionV1Update,
+		DeleteContext: resourceEriRouterToAWSConnectionV1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"source_router_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"source_group_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"group_1", "group_2", "group_3", "group_4",
+					"group_5", "group_6", "group_7", "group_8",
+				}, false),
+			},
+
+			"source_route_filter_in": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice(
+					[]string{"fullRoute", "noRoute"}, false),
+			},
+
+			"source_route_filter_out": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice(
+					[]string{"fullRoute", "fullRouteWithDefaultRoute", "defaultRoute", "privateRoute", "noRoute"}, false),
+			},
+
+			"destination_aws_account_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(awsAccountIDRegexp, "must be a 12 digit AWS account ID"),
+			},
+
+			"destination_qos_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice(
+					[]string{"guarantee"}, false),
+			},
+
+			"destination_primary_interconnect": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"destination_primary_asn": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// The secondary is required, since a connection from a single
+			// router is created with a different request, see
+			// router_single_to_aws_connections. go-fic sends the secondary
+			// even if it is empty, as omitempty has no effect on a struct.
+			"destination_secondary_interconnect": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"destination_secondary_asn": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"primary_connected_network_address": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"secondary_connected_network_address": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"bandwidth": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(awsConnectionBandwidths, false),
+			},
+
+			"redundant": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"operation_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"operation_status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceEriRouterToAWSConnectionV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	routerID := d.Get("source_router_id").(string)
+	osMutexKV.Lock(routerID)
+	defer osMutexKV.Unlock(routerID)
+
+	config := meta.(*Config)
+	client, err := config.eriV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating FIC ERI client: %s", err)
+	}
+
+	routeFilter := connections.RouteFilter{
+		In:  d.Get("source_route_filter_in").(string),
+		Out: d.Get("source_route_filter_out").(string),
+	}
+
+	source := connections.Source{
+		RouterID:    d.Get("source_router_id").(string),
+		GroupName:   d.Get("source_group_name").(string),
+		RouteFilter: routeFilter,
+	}
+
+	destination := connections.Destination{
+		AWSAccountID: d.Get("destination_aws_account_id").(string),
+		QosType:      d.Get("destination_qos_type").(string),
+		Primary: connections.DestinationHAInfo{
+			Interconnect: d.Get("destination_primary_interconnect").(string),
+			ASN:          d.Get("destination_primary_asn").(string),
+		},
+		Secondary: connections.DestinationHAInfo{
+			Interconnect: d.Get("destination_secondary_interconnect").(string),
+			ASN:          d.Get("destination_secondary_asn").(string),
+		},
+	}
+
+	createOpts := &connections.CreateOpts{
+		Name:                             d.Get("name").(string),
+		Source:                           source,
+		Destination:                      destination,
+		Bandwidth:                        d.Get("bandwidth").(string),
+		PrimaryConnectedNetworkAddress:   d.Get("primary_connected_network_address").(string),
+		SecondaryConnectedNetworkAddress: d.Get("secondary_connected_network_address").(string),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	r, err := connections.Create(client, createOpts).Extract()
+	if err != nil {
+		return diag.Errorf("Error creating FIC ERI router to aws connection: %s", err)
+	}
+
+	d.SetId(r.ID)
+
+	log.Printf("[INFO] Connection ID: %s", r.ID)
+
+	log.Printf(
+		"[DEBUG] Waiting for router to aws connection (%s) to become available", r.ID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"Processing"},
+		Target:     []string{"Completed"},
+		Refresh:    resourceRouterToAWSConnectionV1StateRefreshFunc(client, r.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf(
+			"Error waiting for router to aws connection (%s) to become ready: %s", r.ID, err)
+	}
+
+	return resourceEriRouterToAWSConnectionV1Read(ctx, d, meta)
+}
+
+func resourceEriRouterToAWSConnectionV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	client, err := config.eriV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating FIC ERI client: %s", err)
+	}
+
+	r, err := connections.Get(client, d.Id()).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "connection"))
+	}
+
+	log.Printf("[DEBUG] Retrieved router to aws connection %s: %+v", d.Id(), r)
+
+	d.Set("name", r.Name)
+
+	d.Set("source_router_id", r.Source.RouterID)
+	d.Set("source_group_name", r.Source.GroupName)
+	d.Set("source_route_filter_in", r.Source.RouteFilter.In)
+	d.Set("source_route_filter_out", r.Source.RouteFilter.Out)
+
+	d.Set("destination_aws_account_id", r.Destination.AWSAccountID)
+	d.Set("destination_qos_type", r.Destination.QosType)
+	d.Set("destination_primary_interconnect", r.Destination.Primary.Interconnect)
+	d.Set("destination_primary_asn", r.Destination.Primary.ASN)
+	d.Set("destination_secondary_interconnect", r.Destination.Secondary.Interconnect)
+	d.Set("destination_secondary_asn", r.Destination.Secondary.ASN)
+
+	d.Set("primary_connected_network_address", r.PrimaryConnectedNetworkAddress)
+	d.Set("secondary_connected_network_address", r.SecondaryConnectedNetworkAddress)
+
+	d.Set("bandwidth", r.Bandwidth)
+	d.Set("redundant", r.Redundant)
+	d.Set("tenant_id", r.TenantID)
+	d.Set("operation_id", r.OperationID)
+	d.Set("operation_status", r.OperationStatus)
+
+	return operationStatusWarning("connection", d.Id(), r.OperationStatus)
+}
+
+func resourceEriRouterToAWSConnectionV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	routerID := d.Get("source_router_id").(string)
+	osMutexKV.Lock(routerID)
+	defer osMutexKV.Unlock(routerID)
+
+	config := meta.(*Config)
+	client, err := config.eriV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating FIC ERI client: %s", err)
+	}
+
+	if attribute := changedAttribute(d, "source_route_filter_in", "source_route_filter_out"); attribute != "" {
+		routeFilter := connections.RouteFilter{
+			In:  d.Get("source_route_filter_in").(string),
+			Out: d.Get("source_route_filter_out").(string),
+		}
+
+		source := connections.SourceForUpdate{
+			RouteFilter: routeFilter,
+		}
+
+		updateOpts := connections.UpdateOpts{
+			Source: source,
+		}
+
+		_, err := connections.Update(client, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return attributeError(attribute, "Error updating FIC ERI router to aws connection", err)
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"Processing"},
+			Target:     []string{"Completed"},
+			Refresh:    resourceRouterToAWSConnectionV1StateRefreshFunc(client, d.Id()),
+			Timeout:    d.Timeout(schema.TimeoutCreate),
+			Delay:      10 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+
+		log.Printf("[DEBUG] Waiting for router to aws connection (%s) to become complete", d.Id())
+		_, err = stateConf.WaitForStateContext(ctx)
+		if err != nil {
+			return diag.Errorf("Error waiting for router to aws connection (%s) to become complete: %s", d.Id(), err)
+		}
+	}
+
+	return resourceEriRouterToAWSConnectionV1Read(ctx, d, meta)
+}
+
+func resourceEriRouterToAWSConnectionV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	routerID := d.Get("source_router_id").(string)
+	osMutexKV.Lock(routerID)
+	defer osMutexKV.Unlock(routerID)
+
+	config := meta.(*Config)
+	client, err := config.eriV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating FIC ERI client: %s", err)
+	}
+
+	if err := connections.Delete(client, d.Id()).ExtractErr(); err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "connection"))
+	}
+
+	log.Printf("[DEBUG] Waiting for router to aws connection (%s) to delete", d.Id())
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"Processing", "Completed"},
+		Target:     []string{"Deleted"},
+		Refresh:    resourceRouterToAWSConnectionV1StateRefreshFunc(client, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf(
+			"Error waiting for router to aws connection (%s) to delete: %s",
+			d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceRouterToAWSConnectionV1StateRefreshFunc(client *fic.ServiceClient, connectionID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		v, err := connections.Get(client, connectionID).Extract()
+		if err != nil {
+			if _, ok := err.(fic.ErrDefault404); ok {
+				return v, "Deleted", nil
+			}
+			return nil, "", err
+		}
+
+		if v.OperationStatus == "Error" {
+			return v, v.OperationStatus, operationError(client, v.OperationID, fmt.Errorf("there was an error retrieving the router to aws connection information"))
+		}
+
+		return v, v.OperationStatus, nil
+	}
+}
@@ -0,0 +1,312 @@
+package fic
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	connections "github.com/nttcom/go-fic/fic/eri/v1/router_paired_to_aws_connections"
+	"github.com/nttcom/terraform-provider-fic/fic/testhelper/mock"
+)
+
+func TestAccEriRouterToAWSConnectionV1Basic(t *testing.T) {
+	var c connections.Connection
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckArea(t)
+			testAccPreCheckAWSConnection(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEriRouterToAWSConnectionV1Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccConfigEriRouterToAWSConnectionV1Basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEriRouterToAWSConnectionV1Exists("fic_eri_router_to_aws_connection_v1.connection_1", &c),
+					resource.TestCheckResourceAttr(
+						"fic_eri_router_to_aws_connection_v1.connection_1", "operation_status", "Completed"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccConfigEriRouterToAWSConnectionV1Update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEriRouterToAWSConnectionV1Exists("fic_eri_router_to_aws_connection_v1.connection_1", &c),
+					resource.TestCheckResourceAttr(
+						"fic_eri_router_to_aws_connection_v1.connection_1", "source_route_filter_in", "noRoute"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEriRouterToAWSConnectionV1Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	client, err := config.eriV1Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating FIC ERI client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "fic_eri_router_to_aws_connection_v1" {
+			continue
+		}
+
+		_, err := connections.Get(client, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Connection still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckEriRouterToAWSConnectionV1Exists(n string, c *connections.Connection) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		client, err := config.eriV1Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating FIC ERI client: %s", err)
+		}
+
+		found, err := connections.Get(client, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Connection not found")
+		}
+
+		*c = *found
+
+		return nil
+	}
+}
+
+const testAccConfigEriRouterToAWSConnectionV1Template = `
+resource "fic_eri_router_v1" "router_1" {
+    name = "terraform_router_1"
+    area = "%s"
+    user_ip_address = "10.0.0.0/27"
+    redundant = true
+}
+
+resource "fic_eri_router_to_aws_connection_v1" "connection_1" {
+    name = "terraform_connection_1"
+
+    source_router_id = "${fic_eri_router_v1.router_1.id}"
+    source_group_name = "group_1"
+    source_route_filter_in = "%s"
+    source_route_filter_out = "%s"
+
+    destination_aws_account_id = "%s"
+    destination_qos_type = "guarantee"
+    destination_primary_interconnect = "Equinix-TY2-2"
+    destination_primary_asn = "65000"
+    destination_secondary_interconnect = "@Tokyo-CC2-2"
+    destination_secondary_asn = "65000"
+
+    primary_connected_network_address = "10.10.0.0/30"
+    secondary_connected_network_address = "10.20.0.0/30"
+
+    bandwidth = "50M"
+}
+`
+
+var testAccConfigEriRouterToAWSConnectionV1Basic = fmt.Sprintf(
+	testAccConfigEriRouterToAWSConnectionV1Template,
+	OS_AREA_NAME, "fullRoute", "fullRoute", OS_AWS_ACCOUNT_ID,
+)
+
+var testAccConfigEriRouterToAWSConnectionV1Update = fmt.Sprintf(
+	testAccConfigEriRouterToAWSConnectionV1Template,
+	OS_AREA_NAME, "noRoute", "noRoute", OS_AWS_ACCOUNT_ID,
+)
+
+const testMockEriV1RouterToAWSConnectionGet = `
+request:
+  method: GET
+response:
+  code: 200
+  body: >
+    {
+      "connection": {
+        "id": "F030000000000011",
+        "tenantId": "87e89b8f075a4ee1aa209f6ca6ce242c",
+        "name": "aws-1",
+        "redundant": true,
+        "bandwidth": "50M",
+        "source": {
+          "routerId": "F022000000000001",
+          "groupName": "group_1",
+          "routeFilter": {"in": "fullRoute", "out": "privateRoute"}
+        },
+        "destination": {
+          "awsAccountId": "123456789012",
+          "qosType": "guarantee",
+          "primary": {"interconnect": "Equinix-TY2-2", "asn": "65000"},
+          "secondary": {"interconnect": "@Tokyo-CC2-2", "asn": "65001"}
+        },
+        "primaryConnectedNwAddress": "10.10.0.0/30",
+        "secondaryConnectedNwAddress": "10.20.0.0/30",
+        "operationId": "cc43d0f05df24b1aabdea46456d46e39",
+        "operationStatus": "Completed"
+      }
+    }
+`
+
+const testMockEriV1RouterToAWSConnectionError = `
+request:
+  method: GET
+response:
+  code: 200
+  body: >
+    {
+      "connection": {
+        "id": "F030000000000012",
+        "operationId": "cc43d0f05df24b1aabdea46456d46e39",
+        "operationStatus": "Error"
+      }
+    }
+`
+
+const testMockEriV1RouterToAWSConnectionNotFound = `
+request:
+  method: GET
+response:
+  code: 404
+`
+
+func testConfigWithMockRouterToAWSConnections(t *testing.T) (*mock.MockController, *Config) {
+	mc, config := testConfigWithMockKeystone(t, func(mc *mock.MockController) {
+		mc.Register(t, "connection", "/public/v1/router-to-aws-connections/F030000000000011", testMockEriV1RouterToAWSConnectionGet)
+		mc.Register(t, "failed", "/public/v1/router-to-aws-connections/F030000000000012", testMockEriV1RouterToAWSConnectionError)
+		mc.Register(t, "missing", "/public/v1/router-to-aws-connections/F030000000000019", testMockEriV1RouterToAWSConnectionNotFound)
+		mc.Register(t, "operation", "/public/v1/operations/cc43d0f05df24b1aabdea46456d46e39", testMockEriV1OperationError)
+	})
+
+	if err := config.LoadAndValidate(); err != nil {
+		mc.TerminateMockControllerSafety()
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	return mc, config
+}
+
+func TestEriRouterToAWSConnectionV1Read(t *testing.T) {
+	mc, config := testConfigWithMockRouterToAWSConnections(t)
+	defer mc.TerminateMockControllerSafety()
+
+	r := resourceEriRouterToAWSConnectionV1()
+	d := r.TestResourceData()
+	d.SetId("F030000000000011")
+
+	if diags := r.ReadContext(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	expected := map[string]string{
+		"name":                                "aws-1",
+		"source_router_id":                    "F022000000000001",
+		"source_group_name":                   "group_1",
+		"source_route_filter_in":              "fullRoute",
+		"source_route_filter_out":             "privateRoute",
+		"destination_aws_account_id":          "123456789012",
+		"destination_qos_type":                "guarantee",
+		"destination_primary_interconnect":    "Equinix-TY2-2",
+		"destination_primary_asn":             "65000",
+		"destination_secondary_interconnect":  "@Tokyo-CC2-2",
+		"destination_secondary_asn":           "65001",
+		"primary_connected_network_address":   "10.10.0.0/30",
+		"secondary_connected_network_address": "10.20.0.0/30",
+		"bandwidth":                           "50M",
+		"tenant_id":                           "87e89b8f075a4ee1aa209f6ca6ce242c",
+		"operation_status":                    "Completed",
+	}
+
+	for k, v := range expected {
+		if actual := d.Get(k).(string); actual != v {
+			t.Errorf("Expected %s to be %q, got %q", k, v, actual)
+		}
+	}
+
+	if !d.Get("redundant").(bool) {
+		t.Errorf("Expected redundant to be true")
+	}
+
+	// A connection which is gone is removed from the state.
+	d.SetId("F030000000000019")
+	if diags := r.ReadContext(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	if d.Id() != "" {
+		t.Errorf("Expected the ID to be cleared, got %s", d.Id())
+	}
+}
+
+func TestEriRouterToAWSConnectionV1StateRefreshFunc(t *testing.T) {
+	mc, config := testConfigWithMockRouterToAWSConnections(t)
+	defer mc.TerminateMockControllerSafety()
+
+	client, err := config.eriV1Client("")
+	if err != nil {
+		t.Fatalf("Unable to create ERI client: %s", err)
+	}
+
+	_, status, err := resourceRouterToAWSConnectionV1StateRefreshFunc(client, "F030000000000011")()
+	if err != nil || status != "Completed" {
+		t.Errorf("Expected status Completed, got %q (%v)", status, err)
+	}
+
+	_, status, err = resourceRouterToAWSConnectionV1StateRefreshFunc(client, "F030000000000019")()
+	if err != nil || status != "Deleted" {
+		t.Errorf("Expected status Deleted, got %q (%v)", status, err)
+	}
+
+	_, status, err = resourceRouterToAWSConnectionV1StateRefreshFunc(client, "F030000000000012")()
+	if status != "Error" {
+		t.Errorf("Expected status Error, got %q", status)
+	}
+
+	if err == nil || !strings.Contains(err.Error(), "user ip address overlaps with an existing router") {
+		t.Errorf("Expected the error of the operation, got %v", err)
+	}
+}
+
+func TestEriRouterToAWSConnectionV1Schema(t *testing.T) {
+	s := resourceEriRouterToAWSConnectionV1().Schema
+
+	cases := []struct {
+		key   string
+		value string
+		valid bool
+	}{
+		{"destination_aws_account_id", "123456789012", true},
+		{"destination_aws_account_id", "12345678901", false},
+		{"destination_aws_account_id", "1234-5678-9012", false},
+		{"bandwidth", "50M", true},
+		{"bandwidth", "40M", false},
+	}
+
+	for _, c := range cases {
+		_, errs := s[c.key].ValidateFunc(c.value, c.key)
+		if valid := len(errs) == 0; valid != c.valid {
+			t.Errorf("%s = %q: expected valid to be %t, got %v", c.key, c.value, c.valid, errs)
+		}
+	}
+}
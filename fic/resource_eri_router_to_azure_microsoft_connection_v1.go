@@ -1,25 +1,27 @@
 package fic
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/nttcom/go-fic"
 	connections "github.com/nttcom/go-fic/fic/eri/v1/router_to_azure_microsoft_connections"
 )
 
 func resourceEriRouterToAzureMicrosoftConnectionV1() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceEriRouterToAzureMicrosoftConnectionV1Create,
-		Read:   resourceEriRouterToAzureMicrosoftConnectionV1Read,
-		Update: resourceEriRouterToAzureMicrosoftConnectionV1Update,
-		Delete: resourceEriRouterToAzureMicrosoftConnectionV1Delete,
+		CreateContext: resourceEriRouterToAzureMicrosoftConnectionV1Create,
+		ReadContext:   resourceEriRouterToAzureMicrosoftConnectionV1Read,
+		UpdateContext: resourceEriRouterToAzureMicrosoftConnectionV1Update,
+		DeleteContext: resourceEriRouterToAzureMicrosoftConnectionV1Delete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -79,9 +81,17 @@ func resourceEriRouterToAzureMicrosoftConnectionV1() *schema.Resource {
 			},
 
 			"destination_service_key": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: suppressHashedSecretDiff,
+			},
+
+			"write_only_secrets": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 
 			"destination_advertised_public_prefixes": &schema.Schema{
@@ -140,11 +150,15 @@ func resourceEriRouterToAzureMicrosoftConnectionV1() *schema.Resource {
 	}
 }
 
-func resourceEriRouterToAzureMicrosoftConnectionV1Create(d *schema.ResourceData, meta interface{}) error {
+func resourceEriRouterToAzureMicrosoftConnectionV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	routerID := d.Get("source_router_id").(string)
+	osMutexKV.Lock(routerID)
+	defer osMutexKV.Unlock(routerID)
+
 	config := meta.(*Config)
 	client, err := config.eriV1Client(GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI client: %s", err)
+		return diag.Errorf("Error creating FIC ERI client: %s", err)
 	}
 
 	routeFilter := connections.RouteFilter{
@@ -180,7 +194,7 @@ func resourceEriRouterToAzureMicrosoftConnectionV1Create(d *schema.ResourceData,
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
 	r, err := connections.Create(client, createOpts).Extract()
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI router to azure microsoft connection: %s", err)
+		return diag.Errorf("Error creating FIC ERI router to azure microsoft connection: %s", err)
 	}
 
 	d.SetId(r.ID)
@@ -199,25 +213,25 @@ func resourceEriRouterToAzureMicrosoftConnectionV1Create(d *schema.ResourceData,
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForStateContext(ctx)
 	if err != nil {
-		return fmt.Errorf(
+		return diag.Errorf(
 			"Error waiting for router to azure microsoft connection (%s) to become ready: %s", r.ID, err)
 	}
 
-	return resourceEriRouterToAzureMicrosoftConnectionV1Read(d, meta)
+	return resourceEriRouterToAzureMicrosoftConnectionV1Read(ctx, d, meta)
 }
 
-func resourceEriRouterToAzureMicrosoftConnectionV1Read(d *schema.ResourceData, meta interface{}) error {
+func resourceEriRouterToAzureMicrosoftConnectionV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	client, err := config.eriV1Client(GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI client: %s", err)
+		return diag.Errorf("Error creating FIC ERI client: %s", err)
 	}
 
 	r, err := connections.Get(client, d.Id()).Extract()
 	if err != nil {
-		return CheckDeleted(d, err, "connection")
+		return diag.FromErr(CheckDeleted(d, err, "connection"))
 	}
 
 	log.Printf("[DEBUG] Retrieved router to azure microsoft connection %s: %+v", d.Id(), r)
@@ -231,7 +245,7 @@ func resourceEriRouterToAzureMicrosoftConnectionV1Read(d *schema.ResourceData, m
 
 	d.Set("destination_interconnect", r.Destination.Interconnect)
 	d.Set("destination_qos_type", r.Destination.QosType)
-	d.Set("destination_service_key", r.Destination.ServiceKey)
+	d.Set("destination_service_key", secretForState(d, r.Destination.ServiceKey))
 	d.Set("destination_advertised_public_prefixes", r.Destination.AdvertisedPublicPrefixes)
 
 	d.Set("primary_connected_network_address", r.PrimaryConnectedNetworkAddress)
@@ -242,17 +256,24 @@ func resourceEriRouterToAzureMicrosoftConnectionV1Read(d *schema.ResourceData, m
 	d.Set("tenant_id", r.TenantID)
 	d.Set("area", r.Area)
 
-	return nil
+	d.Set("operation_id", r.OperationID)
+	d.Set("operation_status", r.OperationStatus)
+
+	return operationStatusWarning("connection", d.Id(), r.OperationStatus)
 }
 
-func resourceEriRouterToAzureMicrosoftConnectionV1Update(d *schema.ResourceData, meta interface{}) error {
+func resourceEriRouterToAzureMicrosoftConnectionV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	routerID := d.Get("source_router_id").(string)
+	osMutexKV.Lock(routerID)
+	defer osMutexKV.Unlock(routerID)
+
 	config := meta.(*Config)
 	client, err := config.eriV1Client(GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI client: %s", err)
+		return diag.Errorf("Error creating FIC ERI client: %s", err)
 	}
 
-	if d.HasChange("source_route_filter_in") || d.HasChange("source_route_filter_out") || d.HasChange("destination_advertised_public_prefixes") {
+	if attribute := changedAttribute(d, "source_route_filter_in", "source_route_filter_out", "destination_advertised_public_prefixes"); attribute != "" {
 		routeFilter := connections.RouteFilter{
 			In:  d.Get("source_route_filter_in").(string),
 			Out: d.Get("source_route_filter_out").(string),
@@ -278,7 +299,7 @@ func resourceEriRouterToAzureMicrosoftConnectionV1Update(d *schema.ResourceData,
 
 		_, err := connections.Update(client, d.Id(), updateOpts).Extract()
 		if err != nil {
-			return fmt.Errorf("Error updating FIC ERI router to azure microsoft connection: %s", err)
+			return attributeError(attribute, "Error updating FIC ERI router to azure microsoft connection", err)
 		}
 
 		log.Printf(
@@ -294,24 +315,28 @@ func resourceEriRouterToAzureMicrosoftConnectionV1Update(d *schema.ResourceData,
 		}
 
 		log.Printf("[DEBUG] Waiting for router to azure microsoft connection (%s) to become complete", d.Id())
-		_, err = stateConf.WaitForState()
+		_, err = stateConf.WaitForStateContext(ctx)
 		if err != nil {
-			return fmt.Errorf("Error waiting for router to azure microsoft connection (%s) to become complete: %s", d.Id(), err)
+			return diag.Errorf("Error waiting for router to azure microsoft connection (%s) to become complete: %s", d.Id(), err)
 		}
 	}
 
-	return resourceEriRouterToAzureMicrosoftConnectionV1Read(d, meta)
+	return resourceEriRouterToAzureMicrosoftConnectionV1Read(ctx, d, meta)
 }
 
-func resourceEriRouterToAzureMicrosoftConnectionV1Delete(d *schema.ResourceData, meta interface{}) error {
+func resourceEriRouterToAzureMicrosoftConnectionV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	routerID := d.Get("source_router_id").(string)
+	osMutexKV.Lock(routerID)
+	defer osMutexKV.Unlock(routerID)
+
 	config := meta.(*Config)
 	client, err := config.eriV1Client(GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI client: %s", err)
+		return diag.Errorf("Error creating FIC ERI client: %s", err)
 	}
 
 	if err := connections.Delete(client, d.Id()).ExtractErr(); err != nil {
-		return CheckDeleted(d, err, "connection")
+		return diag.FromErr(CheckDeleted(d, err, "connection"))
 	}
 
 	log.Printf("[DEBUG] Waiting for router to azure microsoft connection (%s) to delete", d.Id())
@@ -325,9 +350,9 @@ func resourceEriRouterToAzureMicrosoftConnectionV1Delete(d *schema.ResourceData,
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForStateContext(ctx)
 	if err != nil {
-		return fmt.Errorf(
+		return diag.Errorf(
 			"Error waiting for router to azure microsoft connection (%s) to delete: %s",
 			d.Id(), err)
 	}
@@ -347,7 +372,7 @@ func resourceRouterToAzureMicrosoftConnectionV1StateRefreshFunc(client *fic.Serv
 		}
 
 		if v.OperationStatus == "Error" {
-			return v, v.OperationStatus, fmt.Errorf("there was an error retrieving the router to azure microsoft connection information")
+			return v, v.OperationStatus, operationError(client, v.OperationID, fmt.Errorf("there was an error retrieving the router to azure microsoft connection information"))
 		}
 
 		return v, v.OperationStatus, nil
@@ -1,25 +1,27 @@
 package fic
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/nttcom/go-fic"
 	connections "github.com/nttcom/go-fic/fic/eri/v1/router_to_azure_private_connections"
 )
 
 func resourceEriRouterToAzurePrivateConnectionV1() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceEriRouterToAzurePrivateConnectionV1Create,
-		Read:   resourceEriRouterToAzurePrivateConnectionV1Read,
-		Update: resourceEriRouterToAzurePrivateConnectionV1Update,
-		Delete: resourceEriRouterToAzurePrivateConnectionV1Delete,
+		CreateContext: resourceEriRouterToAzurePrivateConnectionV1Create,
+		ReadContext:   resourceEriRouterToAzurePrivateConnectionV1Read,
+		UpdateContext: resourceEriRouterToAzurePrivateConnectionV1Update,
+		DeleteContext: resourceEriRouterToAzurePrivateConnectionV1Delete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -79,9 +81,17 @@ func resourceEriRouterToAzurePrivateConnectionV1() *schema.Resource {
 			},
 
 			"destination_service_key": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: suppressHashedSecretDiff,
+			},
+
+			"write_only_secrets": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 
 			"primary_connected_network_address": &schema.Schema{
@@ -136,11 +146,15 @@ func resourceEriRouterToAzurePrivateConnectionV1() *schema.Resource {
 	}
 }
 
-func resourceEriRouterToAzurePrivateConnectionV1Create(d *schema.ResourceData, meta interface{}) error {
+func resourceEriRouterToAzurePrivateConnectionV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	routerID := d.Get("source_router_id").(string)
+	osMutexKV.Lock(routerID)
+	defer osMutexKV.Unlock(routerID)
+
 	config := meta.(*Config)
 	client, err := config.eriV1Client(GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI client: %s", err)
+		return diag.Errorf("Error creating FIC ERI client: %s", err)
 	}
 
 	routeFilter := connections.RouteFilter{
@@ -172,7 +186,7 @@ func resourceEriRouterToAzurePrivateConnectionV1Create(d *schema.ResourceData, m
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
 	r, err := connections.Create(client, createOpts).Extract()
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI router to azure private connection: %s", err)
+		return diag.Errorf("Error creating FIC ERI router to azure private connection: %s", err)
 	}
 
 	d.SetId(r.ID)
@@ -191,25 +205,25 @@ func resourceEriRouterToAzurePrivateConnectionV1Create(d *schema.ResourceData, m
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForStateContext(ctx)
 	if err != nil {
-		return fmt.Errorf(
+		return diag.Errorf(
 			"Error waiting for router to azure private connection (%s) to become ready: %s", r.ID, err)
 	}
 
-	return resourceEriRouterToAzurePrivateConnectionV1Read(d, meta)
+	return resourceEriRouterToAzurePrivateConnectionV1Read(ctx, d, meta)
 }
 
-func resourceEriRouterToAzurePrivateConnectionV1Read(d *schema.ResourceData, meta interface{}) error {
+func resourceEriRouterToAzurePrivateConnectionV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	client, err := config.eriV1Client(GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI client: %s", err)
+		return diag.Errorf("Error creating FIC ERI client: %s", err)
 	}
 
 	r, err := connections.Get(client, d.Id()).Extract()
 	if err != nil {
-		return CheckDeleted(d, err, "connection")
+		return diag.FromErr(CheckDeleted(d, err, "connection"))
 	}
 
 	log.Printf("[DEBUG] Retrieved router to azure private connection %s: %+v", d.Id(), r)
@@ -223,7 +237,7 @@ func resourceEriRouterToAzurePrivateConnectionV1Read(d *schema.ResourceData, met
 
 	d.Set("destination_interconnect", r.Destination.Interconnect)
 	d.Set("destination_qos_type", r.Destination.QosType)
-	d.Set("destination_service_key", r.Destination.ServiceKey)
+	d.Set("destination_service_key", secretForState(d, r.Destination.ServiceKey))
 
 	d.Set("primary_connected_network_address", r.PrimaryConnectedNetworkAddress)
 	d.Set("secondary_connected_network_address", r.SecondaryConnectedNetworkAddress)
@@ -233,17 +247,24 @@ func resourceEriRouterToAzurePrivateConnectionV1Read(d *schema.ResourceData, met
 	d.Set("tenant_id", r.TenantID)
 	d.Set("area", r.Area)
 
-	return nil
+	d.Set("operation_id", r.OperationID)
+	d.Set("operation_status", r.OperationStatus)
+
+	return operationStatusWarning("connection", d.Id(), r.OperationStatus)
 }
 
-func resourceEriRouterToAzurePrivateConnectionV1Update(d *schema.ResourceData, meta interface{}) error {
+func resourceEriRouterToAzurePrivateConnectionV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	routerID := d.Get("source_router_id").(string)
+	osMutexKV.Lock(routerID)
+	defer osMutexKV.Unlock(routerID)
+
 	config := meta.(*Config)
 	client, err := config.eriV1Client(GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI client: %s", err)
+		return diag.Errorf("Error creating FIC ERI client: %s", err)
 	}
 
-	if d.HasChange("source_route_filter_in") || d.HasChange("source_route_filter_out") {
+	if attribute := changedAttribute(d, "source_route_filter_in", "source_route_filter_out"); attribute != "" {
 		routeFilter := connections.RouteFilter{
 			In:  d.Get("source_route_filter_in").(string),
 			Out: d.Get("source_route_filter_out").(string),
@@ -259,7 +280,7 @@ func resourceEriRouterToAzurePrivateConnectionV1Update(d *schema.ResourceData, m
 
 		_, err := connections.Update(client, d.Id(), updateOpts).Extract()
 		if err != nil {
-			return fmt.Errorf("Error updating FIC ERI router to azure private connection: %s", err)
+			return attributeError(attribute, "Error updating FIC ERI router to azure private connection", err)
 		}
 
 		log.Printf(
@@ -275,24 +296,28 @@ func resourceEriRouterToAzurePrivateConnectionV1Update(d *schema.ResourceData, m
 		}
 
 		log.Printf("[DEBUG] Waiting for router to azure private connection (%s) to become complete", d.Id())
-		_, err = stateConf.WaitForState()
+		_, err = stateConf.WaitForStateContext(ctx)
 		if err != nil {
-			return fmt.Errorf("Error waiting for router to azure private connection (%s) to become complete: %s", d.Id(), err)
+			return diag.Errorf("Error waiting for router to azure private connection (%s) to become complete: %s", d.Id(), err)
 		}
 	}
 
-	return resourceEriRouterToAzurePrivateConnectionV1Read(d, meta)
+	return resourceEriRouterToAzurePrivateConnectionV1Read(ctx, d, meta)
 }
 
-func resourceEriRouterToAzurePrivateConnectionV1Delete(d *schema.ResourceData, meta interface{}) error {
+func resourceEriRouterToAzurePrivateConnectionV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	routerID := d.Get("source_router_id").(string)
+	osMutexKV.Lock(routerID)
+	defer osMutexKV.Unlock(routerID)
+
 	config := meta.(*Config)
 	client, err := config.eriV1Client(GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI client: %s", err)
+		return diag.Errorf("Error creating FIC ERI client: %s", err)
 	}
 
 	if err := connections.Delete(client, d.Id()).ExtractErr(); err != nil {
-		return CheckDeleted(d, err, "connection")
+		return diag.FromErr(CheckDeleted(d, err, "connection"))
 	}
 
 	log.Printf("[DEBUG] Waiting for router to azure private connection (%s) to delete", d.Id())
@@ -306,9 +331,9 @@ func resourceEriRouterToAzurePrivateConnectionV1Delete(d *schema.ResourceData, m
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForStateContext(ctx)
 	if err != nil {
-		return fmt.Errorf(
+		return diag.Errorf(
 			"Error waiting for router to azure private connection (%s) to delete: %s",
 			d.Id(), err)
 	}
@@ -328,7 +353,7 @@ func resourceRouterToAzurePrivateConnectionV1StateRefreshFunc(client *fic.Servic
 		}
 
 		if v.OperationStatus == "Error" {
-			return v, v.OperationStatus, fmt.Errorf("there was an error retrieving the router to azure private connection information")
+			return v, v.OperationStatus, operationError(client, v.OperationID, fmt.Errorf("there was an error retrieving the router to azure private connection information"))
 		}
 
 		return v, v.OperationStatus, nil
@@ -1,13 +1,15 @@
 package fic
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/nttcom/go-fic"
 	connections "github.com/nttcom/go-fic/fic/eri/v1/router_to_ecl_connections"
@@ -15,12 +17,12 @@ import (
 
 func resourceEriRouterToECLConnectionV1() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceEriRouterToECLConnectionV1Create,
-		Read:   resourceEriRouterToECLConnectionV1Read,
-		Update: resourceEriRouterToECLConnectionV1Update,
-		Delete: resourceEriRouterToECLConnectionV1Delete,
+		CreateContext: resourceEriRouterToECLConnectionV1Create,
+		ReadContext:   resourceEriRouterToECLConnectionV1Read,
+		UpdateContext: resourceEriRouterToECLConnectionV1Update,
+		DeleteContext: resourceEriRouterToECLConnectionV1Delete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -90,15 +92,29 @@ func resourceEriRouterToECLConnectionV1() *schema.Resource {
 			},
 
 			"destination_ecl_api_key": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: suppressHashedSecretDiff,
 			},
 
 			"destination_ecl_api_secret_key": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: suppressHashedSecretDiff,
+			},
+
+			// The API does not return the credentials, so the state can not be
+			// switched between hashes and plain values without recreating the
+			// connection from the configured credentials.
+			"write_only_secrets": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
 				ForceNew: true,
+				Default:  false,
 			},
 
 			"primary_connected_network_address": &schema.Schema{
@@ -138,15 +154,29 @@ func resourceEriRouterToECLConnectionV1() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+
+			"operation_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"operation_status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
 
-func resourceEriRouterToECLConnectionV1Create(d *schema.ResourceData, meta interface{}) error {
+func resourceEriRouterToECLConnectionV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	routerID := d.Get("source_router_id").(string)
+	osMutexKV.Lock(routerID)
+	defer osMutexKV.Unlock(routerID)
+
 	config := meta.(*Config)
 	client, err := config.eriV1Client(GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI client: %s", err)
+		return diag.Errorf("Error creating FIC ERI client: %s", err)
 	}
 
 	routeFilter := connections.RouteFilter{
@@ -180,7 +210,7 @@ func resourceEriRouterToECLConnectionV1Create(d *schema.ResourceData, meta inter
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
 	r, err := connections.Create(client, createOpts).Extract()
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI connection(router to ecl): %s", err)
+		return diag.Errorf("Error creating FIC ERI connection(router to ecl): %s", err)
 	}
 
 	d.SetId(r.ID)
@@ -199,25 +229,25 @@ func resourceEriRouterToECLConnectionV1Create(d *schema.ResourceData, meta inter
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForStateContext(ctx)
 	if err != nil {
-		return fmt.Errorf(
+		return diag.Errorf(
 			"Error waiting for connection (%s) to become ready: %s", r.ID, err)
 	}
 
-	return resourceEriRouterToECLConnectionV1Read(d, meta)
+	return resourceEriRouterToECLConnectionV1Read(ctx, d, meta)
 }
 
-func resourceEriRouterToECLConnectionV1Read(d *schema.ResourceData, meta interface{}) error {
+func resourceEriRouterToECLConnectionV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	client, err := config.eriV1Client(GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI client: %s", err)
+		return diag.Errorf("Error creating FIC ERI client: %s", err)
 	}
 
 	r, err := connections.Get(client, d.Id()).Extract()
 	if err != nil {
-		return CheckDeleted(d, err, "connection")
+		return diag.FromErr(CheckDeleted(d, err, "connection"))
 	}
 
 	log.Printf("[DEBUG] Retrieved connection %s: %+v", d.Id(), r)
@@ -233,24 +263,42 @@ func resourceEriRouterToECLConnectionV1Read(d *schema.ResourceData, meta interfa
 	d.Set("destination_inter_connect", r.Destination.Interconnect)
 	d.Set("destination_qos_type", r.Destination.QosType)
 
+	// The API does not return the credentials, so the configured ones are kept,
+	// and hashed if write_only_secrets is set. The data source has no
+	// credentials, hence the checked type assertions.
+	apiKey, _ := d.Get("destination_ecl_api_key").(string)
+	apiSecretKey, _ := d.Get("destination_ecl_api_secret_key").(string)
+	d.Set("destination_ecl_api_key", secretForState(d, apiKey))
+	d.Set("destination_ecl_api_secret_key", secretForState(d, apiSecretKey))
+
 	d.Set("bandwidth", r.Bandwidth)
 	d.Set("redundant", r.Redundant)
 	d.Set("tenant_id", r.TenantID)
 	d.Set("area", r.Area)
 
-	return nil
+	d.Set("operation_id", r.OperationID)
+	d.Set("operation_status", r.OperationStatus)
+
+	return operationStatusWarning("connection", d.Id(), r.OperationStatus)
 }
 
-func resourceEriRouterToECLConnectionV1Update(d *schema.ResourceData, meta interface{}) error {
+func resourceEriRouterToECLConnectionV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	routerID := d.Get("source_router_id").(string)
+	osMutexKV.Lock(routerID)
+	defer osMutexKV.Unlock(routerID)
+
 	config := meta.(*Config)
 	client, err := config.eriV1Client(GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI client: %s", err)
+		return diag.Errorf("Error creating FIC ERI client: %s", err)
 	}
 
 	var updateOptsList = []connections.UpdateOpts{}
+	// attributes holds the argument each of updateOptsList updates.
+	var attributes []string
 
 	if d.HasChange("name") {
+		attributes = append(attributes, "name")
 		updateOptsList = append(updateOptsList,
 			connections.UpdateOpts{
 				Name: d.Get("name").(string),
@@ -258,7 +306,8 @@ func resourceEriRouterToECLConnectionV1Update(d *schema.ResourceData, meta inter
 		)
 	}
 
-	if d.HasChange("source_route_filter_in") || d.HasChange("source_route_filter_out") {
+	if attribute := changedAttribute(d, "source_route_filter_in", "source_route_filter_out"); attribute != "" {
+		attributes = append(attributes, attribute)
 		routeFilter := connections.RouteFilter{
 			In:  d.Get("source_route_filter_in").(string),
 			Out: d.Get("source_route_filter_out").(string),
@@ -274,6 +323,7 @@ func resourceEriRouterToECLConnectionV1Update(d *schema.ResourceData, meta inter
 	}
 
 	if d.HasChange("bandwidth") {
+		attributes = append(attributes, "bandwidth")
 		updateOptsList = append(updateOptsList,
 			connections.UpdateOpts{
 				Bandwidth: d.Get("bandwidth").(string),
@@ -281,10 +331,10 @@ func resourceEriRouterToECLConnectionV1Update(d *schema.ResourceData, meta inter
 		)
 	}
 
-	for _, updateOpts := range updateOptsList {
+	for i, updateOpts := range updateOptsList {
 		_, err := connections.Update(client, d.Id(), updateOpts).Extract()
 		if err != nil {
-			return fmt.Errorf("Error activating FIC ERI connection: %s", err)
+			return attributeError(attributes[i], "Error updating FIC ERI connection", err)
 		}
 
 		log.Printf(
@@ -300,24 +350,28 @@ func resourceEriRouterToECLConnectionV1Update(d *schema.ResourceData, meta inter
 		}
 
 		log.Printf("[DEBUG] Waiting for connection (%s) to become complete", d.Id())
-		_, err = stateConf.WaitForState()
+		_, err = stateConf.WaitForStateContext(ctx)
 		if err != nil {
-			return fmt.Errorf("Error waiting for connection (%s) to become complete: %s", d.Id(), err)
+			return diag.Errorf("Error waiting for connection (%s) to become complete: %s", d.Id(), err)
 		}
 	}
 
-	return resourceEriRouterToECLConnectionV1Read(d, meta)
+	return resourceEriRouterToECLConnectionV1Read(ctx, d, meta)
 }
 
-func resourceEriRouterToECLConnectionV1Delete(d *schema.ResourceData, meta interface{}) error {
+func resourceEriRouterToECLConnectionV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	routerID := d.Get("source_router_id").(string)
+	osMutexKV.Lock(routerID)
+	defer osMutexKV.Unlock(routerID)
+
 	config := meta.(*Config)
 	client, err := config.eriV1Client(GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI client: %s", err)
+		return diag.Errorf("Error creating FIC ERI client: %s", err)
 	}
 
 	if err := connections.Delete(client, d.Id()).ExtractErr(); err != nil {
-		return CheckDeleted(d, err, "connection")
+		return diag.FromErr(CheckDeleted(d, err, "connection"))
 	}
 
 	log.Printf("[DEBUG] Waiting for connection (%s) to delete", d.Id())
@@ -331,9 +385,9 @@ func resourceEriRouterToECLConnectionV1Delete(d *schema.ResourceData, meta inter
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForStateContext(ctx)
 	if err != nil {
-		return fmt.Errorf(
+		return diag.Errorf(
 			"Error waiting for connection (%s) to delete: %s",
 			d.Id(), err)
 	}
@@ -353,7 +407,7 @@ func RouterToECLConnectionV1StateRefreshFunc(client *fic.ServiceClient, connecti
 		}
 
 		if v.OperationStatus == "Error" {
-			return v, v.OperationStatus, fmt.Errorf("There was an error retrieving the connection(router to ecl) information.")
+			return v, v.OperationStatus, operationError(client, v.OperationID, fmt.Errorf("There was an error retrieving the connection(router to ecl) information."))
 		}
 
 		return v, v.OperationStatus, nil
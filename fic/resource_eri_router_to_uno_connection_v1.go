@@ -1,26 +1,49 @@
 package fic
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/nttcom/go-fic"
 	connections "github.com/nttcom/go-fic/fic/eri/v1/router_to_uno_connections"
 )
 
+// unoInterconnectsByArea lists the UNO interconnect points offered in each
+// area. FIC has no API to list them, so they are maintained here.
+var unoInterconnectsByArea = map[string][]string{
+	"JPEAST": {
+		"Interconnect-Tokyo-1", "Interconnect-Tokyo-2", "Interconnect-Tokyo-3",
+		"Interconnect-Tokyo-4", "Interconnect-Tokyo-5", "Interconnect-Tokyo-6",
+		"Interconnect-Tokyo-7", "Interconnect-Tokyo-8", "Interconnect-Tokyo-9",
+		"Interconnect-Tokyo-10", "Interconnect-Tokyo-11", "Interconnect-Tokyo-12",
+		"Interconnect-Tokyo-13", "Interconnect-Tokyo-14", "Interconnect-Tokyo-15",
+	},
+	"JPWEST": {
+		"Interconnect-Osaka-1", "Interconnect-Osaka-2", "Interconnect-Osaka-3",
+		"Interconnect-Osaka-4", "Interconnect-Osaka-5",
+	},
+}
+
 func resourceEriRouterToUNOConnectionV1() *schema.Resource {
+	var validInterconnects []string
+	for _, area := range []string{"JPEAST", "JPWEST"} {
+		validInterconnects = append(validInterconnects, unoInterconnectsByArea[area]...)
+	}
+
 	return &schema.Resource{
-		Create: resourceEriRouterToUNOConnectionV1Create,
-		Read:   resourceEriRouterToUNOConnectionV1Read,
-		Update: resourceEriRouterToUNOConnectionV1Update,
-		Delete: resourceEriRouterToUNOConnectionV1Delete,
+		CreateContext: resourceEriRouterToUNOConnectionV1Create,
+		ReadContext:   resourceEriRouterToUNOConnectionV1Read,
+		UpdateContext: resourceEriRouterToUNOConnectionV1Update,
+		DeleteContext: resourceEriRouterToUNOConnectionV1Delete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -66,19 +89,10 @@ func resourceEriRouterToUNOConnectionV1() *schema.Resource {
 			},
 
 			"destination_interconnect": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
-				ValidateFunc: validation.StringInSlice(
-					[]string{
-						"Interconnect-Tokyo-1", "Interconnect-Tokyo-2", "Interconnect-Tokyo-3",
-						"Interconnect-Tokyo-4", "Interconnect-Tokyo-5", "Interconnect-Tokyo-6",
-						"Interconnect-Tokyo-7", "Interconnect-Tokyo-8", "Interconnect-Tokyo-9",
-						"Interconnect-Tokyo-10", "Interconnect-Tokyo-11", "Interconnect-Tokyo-12",
-						"Interconnect-Tokyo-13", "Interconnect-Tokyo-14", "Interconnect-Tokyo-15",
-						"Interconnect-Osaka-1", "Interconnect-Osaka-2", "Interconnect-Osaka-3",
-						"Interconnect-Osaka-4", "Interconnect-Osaka-5",
-					}, false),
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(validInterconnects, false),
 			},
 
 			"destination_c_number": &schema.Schema{
@@ -151,15 +165,29 @@ func resourceEriRouterToUNOConnectionV1() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+
+			"operation_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"operation_status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
 
-func resourceEriRouterToUNOConnectionV1Create(d *schema.ResourceData, meta interface{}) error {
+func resourceEriRouterToUNOConnectionV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	routerID := d.Get("source_router_id").(string)
+	osMutexKV.Lock(routerID)
+	defer osMutexKV.Unlock(routerID)
+
 	config := meta.(*Config)
 	client, err := config.eriV1Client(GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI client: %s", err)
+		return diag.Errorf("Error creating FIC ERI client: %s", err)
 	}
 
 	sourceRouteFilter := connections.SourceRouteFilter{
@@ -197,7 +225,7 @@ func resourceEriRouterToUNOConnectionV1Create(d *schema.ResourceData, meta inter
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
 	r, err := connections.Create(client, createOpts).Extract()
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI connection(router to uno): %s", err)
+		return diag.Errorf("Error creating FIC ERI connection(router to uno): %s", err)
 	}
 
 	d.SetId(r.ID)
@@ -216,9 +244,9 @@ func resourceEriRouterToUNOConnectionV1Create(d *schema.ResourceData, meta inter
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForStateContext(ctx)
 	if err != nil {
-		return fmt.Errorf(
+		return diag.Errorf(
 			"Error waiting for connection (%s) to become ready: %s", r.ID, err)
 	}
 
@@ -226,19 +254,19 @@ func resourceEriRouterToUNOConnectionV1Create(d *schema.ResourceData, meta inter
 	// this value, so this point is only change to store c number into state.
 	log.Printf("[DEBUG] Preserve destination_c_number as: %#v", d.Get("destination_c_number").(string))
 	d.Set("destination_c_number", d.Get("destination_c_number").(string))
-	return resourceEriRouterToUNOConnectionV1Read(d, meta)
+	return resourceEriRouterToUNOConnectionV1Read(ctx, d, meta)
 }
 
-func resourceEriRouterToUNOConnectionV1Read(d *schema.ResourceData, meta interface{}) error {
+func resourceEriRouterToUNOConnectionV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	client, err := config.eriV1Client(GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI client: %s", err)
+		return diag.Errorf("Error creating FIC ERI client: %s", err)
 	}
 
 	r, err := connections.Get(client, d.Id()).Extract()
 	if err != nil {
-		return CheckDeleted(d, err, "connection")
+		return diag.FromErr(CheckDeleted(d, err, "connection"))
 	}
 
 	log.Printf("[DEBUG] Retrieved connection %s: %+v", d.Id(), r)
@@ -268,17 +296,24 @@ func resourceEriRouterToUNOConnectionV1Read(d *schema.ResourceData, meta interfa
 	d.Set("redundant", r.Redundant)
 	d.Set("tenant_id", r.TenantID)
 
-	return nil
+	d.Set("operation_id", r.OperationID)
+	d.Set("operation_status", r.OperationStatus)
+
+	return operationStatusWarning("connection", d.Id(), r.OperationStatus)
 }
 
-func resourceEriRouterToUNOConnectionV1Update(d *schema.ResourceData, meta interface{}) error {
+func resourceEriRouterToUNOConnectionV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	routerID := d.Get("source_router_id").(string)
+	osMutexKV.Lock(routerID)
+	defer osMutexKV.Unlock(routerID)
+
 	config := meta.(*Config)
 	client, err := config.eriV1Client(GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI client: %s", err)
+		return diag.Errorf("Error creating FIC ERI client: %s", err)
 	}
 
-	if d.HasChange("source_route_filter_in") || d.HasChange("source_route_filter_out") || d.HasChange("destination_route_filter_out") {
+	if attribute := changedAttribute(d, "source_route_filter_in", "source_route_filter_out", "destination_route_filter_out"); attribute != "" {
 
 		source := connections.SourceForUpdate{
 			RouteFilter: connections.SourceRouteFilter{
@@ -299,7 +334,7 @@ func resourceEriRouterToUNOConnectionV1Update(d *schema.ResourceData, meta inter
 		log.Printf("[DEBUG] Update Options: %#v", updateOpts)
 		_, err := connections.Update(client, d.Id(), updateOpts).Extract()
 		if err != nil {
-			return fmt.Errorf("Error activating FIC ERI connection: %s", err)
+			return attributeError(attribute, "Error updating FIC ERI connection", err)
 		}
 
 		log.Printf(
@@ -315,24 +350,28 @@ func resourceEriRouterToUNOConnectionV1Update(d *schema.ResourceData, meta inter
 		}
 
 		log.Printf("[DEBUG] Waiting for connection (%s) to become complete", d.Id())
-		_, err = stateConf.WaitForState()
+		_, err = stateConf.WaitForStateContext(ctx)
 		if err != nil {
-			return fmt.Errorf("Error waiting for connection (%s) to become complete: %s", d.Id(), err)
+			return diag.Errorf("Error waiting for connection (%s) to become complete: %s", d.Id(), err)
 		}
 	}
 
-	return resourceEriRouterToUNOConnectionV1Read(d, meta)
+	return resourceEriRouterToUNOConnectionV1Read(ctx, d, meta)
 }
 
-func resourceEriRouterToUNOConnectionV1Delete(d *schema.ResourceData, meta interface{}) error {
+func resourceEriRouterToUNOConnectionV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	routerID := d.Get("source_router_id").(string)
+	osMutexKV.Lock(routerID)
+	defer osMutexKV.Unlock(routerID)
+
 	config := meta.(*Config)
 	client, err := config.eriV1Client(GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI client: %s", err)
+		return diag.Errorf("Error creating FIC ERI client: %s", err)
 	}
 
 	if err := connections.Delete(client, d.Id()).ExtractErr(); err != nil {
-		return CheckDeleted(d, err, "connection")
+		return diag.FromErr(CheckDeleted(d, err, "connection"))
 	}
 
 	log.Printf("[DEBUG] Waiting for connection (%s) to delete", d.Id())
@@ -346,9 +385,9 @@ func resourceEriRouterToUNOConnectionV1Delete(d *schema.ResourceData, meta inter
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForStateContext(ctx)
 	if err != nil {
-		return fmt.Errorf(
+		return diag.Errorf(
 			"Error waiting for connection (%s) to delete: %s",
 			d.Id(), err)
 	}
@@ -368,7 +407,7 @@ func RouterToUNOConnectionV1StateRefreshFunc(client *fic.ServiceClient, connecti
 		}
 
 		if v.OperationStatus == "Error" {
-			return v, v.OperationStatus, fmt.Errorf("There was an error retrieving the connection(router to uno) information.")
+			return v, v.OperationStatus, operationError(client, v.OperationID, fmt.Errorf("There was an error retrieving the connection(router to uno) information."))
 		}
 
 		return v, v.OperationStatus, nil
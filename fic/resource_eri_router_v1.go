@@ -1,13 +1,15 @@
 package fic
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/nttcom/go-fic"
 	"github.com/nttcom/go-fic/fic/eri/v1/routers"
@@ -15,11 +17,11 @@ import (
 
 func resourceEriRouterV1() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceEriRouterV1Create,
-		Read:   resourceEriRouterV1Read,
-		Delete: resourceEriRouterV1Delete,
+		CreateContext: resourceEriRouterV1Create,
+		ReadContext:   resourceEriRouterV1Read,
+		DeleteContext: resourceEriRouterV1Delete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -118,11 +120,11 @@ func resourceEriRouterV1() *schema.Resource {
 	}
 }
 
-func resourceEriRouterV1Create(d *schema.ResourceData, meta interface{}) error {
+func resourceEriRouterV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	client, err := config.eriV1Client(GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI client: %s", err)
+		return diag.Errorf("Error creating FIC ERI client: %s", err)
 	}
 
 	redundant := d.Get("redundant").(bool)
@@ -136,7 +138,7 @@ func resourceEriRouterV1Create(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
 	r, err := routers.Create(client, createOpts).Extract()
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI router: %s", err)
+		return diag.Errorf("Error creating FIC ERI router: %s", err)
 	}
 
 	d.SetId(r.ID)
@@ -155,13 +157,13 @@ func resourceEriRouterV1Create(d *schema.ResourceData, meta interface{}) error {
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForStateContext(ctx)
 	if err != nil {
-		return fmt.Errorf(
+		return diag.Errorf(
 			"Error waiting for router (%s) to become ready: %s", r.ID, err)
 	}
 
-	return resourceEriRouterV1Read(d, meta)
+	return resourceEriRouterV1Read(ctx, d, meta)
 }
 
 func getRouterFirewallForState(r *routers.Router) []map[string]interface{} {
@@ -199,16 +201,16 @@ func getRoutingGroupForState(r *routers.Router) []map[string]interface{} {
 	return result
 }
 
-func resourceEriRouterV1Read(d *schema.ResourceData, meta interface{}) error {
+func resourceEriRouterV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	client, err := config.eriV1Client(GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("Error creating FIC ERI client: %s", err)
+		return diag.Errorf("Error creating FIC ERI client: %s", err)
 	}
 
 	r, err := routers.Get(client, d.Id()).Extract()
 	if err != nil {
-		return CheckDeleted(d, err, "router")
+		return diag.FromErr(CheckDeleted(d, err, "router"))
 	}
 
 	log.Printf("[DEBUG] Retrieved router %s: %+v", d.Id(), r)
@@ -228,17 +230,17 @@ func resourceEriRouterV1Read(d *schema.ResourceData, meta interface{}) error {
 	d.Set("firewall_id", firewallID)
 	d.Set("nat_id", natID)
 
-	return nil
+	return operationStatusWarning("router", d.Id(), r.OperationStatus)
 }
 
-func resourceEriRouterV1Delete(d *schema.ResourceData, meta interface{}) error {
+func resourceEriRouterV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	client, err := config.eriV1Client(GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("error creating FIC ERI client: %w", err)
+		return diag.Errorf("error creating FIC ERI client: %s", err)
 	}
 
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		if err := routers.Delete(client, d.Id()).ExtractErr(); err != nil {
 			var e404 fic.ErrDefault404
 			if errors.As(err, &e404) {
@@ -257,7 +259,7 @@ func resourceEriRouterV1Delete(d *schema.ResourceData, meta interface{}) error {
 	})
 
 	if err != nil {
-		return fmt.Errorf("error deleting FIC ERI router: %w", err)
+		return diag.Errorf("error deleting FIC ERI router: %s", err)
 	}
 
 	d.SetId("")
@@ -276,7 +278,7 @@ func RouterV1StateRefreshFunc(client *fic.ServiceClient, portID string) resource
 		}
 
 		if v.OperationStatus == "Error" {
-			return v, v.OperationStatus, fmt.Errorf("There was an error retrieving the router information.")
+			return v, v.OperationStatus, operationError(client, v.OperationID, fmt.Errorf("There was an error retrieving the router information."))
 		}
 
 		return v, v.OperationStatus, nil
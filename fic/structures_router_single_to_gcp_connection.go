@@ -0,0 +1,108 @@
+package fic
+
+import (
+	"fmt"
+	"strconv"
+
+	connections "github.com/nttcom/go-fic/fic/eri/v1/router_single_to_gcp_connections"
+)
+
+func expandSingleRouterToGCPSource(in []interface{}) connections.Source {
+	m := in[0].(map[string]interface{})
+
+	return connections.Source{
+		RouterID:    m["router_id"].(string),
+		GroupName:   m["group_name"].(string),
+		RouteFilter: expandSingleRouterToGCPRouteFilter(m["route_filter"].([]interface{})),
+		Primary: connections.Primary{
+			ASPathPrepend: connections.ASPathPrepend{
+				In:  expandASPathPrepend(m["as_path_prepend_in"].(string)),
+				Out: expandASPathPrepend(m["as_path_prepend_out"].(string)),
+			},
+		},
+	}
+}
+
+func expandSingleRouterToGCPRouteFilter(in []interface{}) connections.RouteFilter {
+	m := in[0].(map[string]interface{})
+
+	return connections.RouteFilter{
+		In:  m["in"].(string),
+		Out: m["out"].(string),
+	}
+}
+
+func expandSingleRouterToGCPDestination(in []interface{}) connections.Destination {
+	m := in[0].(map[string]interface{})
+	primary := m["primary"].([]interface{})[0].(map[string]interface{})
+
+	return connections.Destination{
+		QosType: "guarantee",
+		Primary: connections.DestinationHAInfo{
+			Interconnect: primary["interconnect"].(string),
+			PairingKey:   primary["pairing_key"].(string),
+		},
+	}
+}
+
+// expandASPathPrepend converts the number of AS path prepends to the API
+// value, in which null turns prepending off.
+func expandASPathPrepend(v string) *interface{} {
+	var out interface{}
+	if v != "OFF" && v != "" {
+		n, _ := strconv.Atoi(v)
+		out = n
+	}
+
+	return &out
+}
+
+func flattenSingleRouterToGCPSource(in connections.Source) []interface{} {
+	var out []interface{}
+	m := make(map[string]interface{})
+
+	m["router_id"] = in.RouterID
+	m["group_name"] = in.GroupName
+	m["route_filter"] = flattenSingleRouterToGCPRouteFilter(in.RouteFilter)
+	m["as_path_prepend_in"] = flattenASPathPrepend(in.Primary.ASPathPrepend.In)
+	m["as_path_prepend_out"] = flattenASPathPrepend(in.Primary.ASPathPrepend.Out)
+
+	out = append(out, m)
+	return out
+}
+
+func flattenSingleRouterToGCPRouteFilter(in connections.RouteFilter) []interface{} {
+	var out []interface{}
+	m := make(map[string]interface{})
+
+	m["in"] = in.In
+	m["out"] = in.Out
+
+	out = append(out, m)
+	return out
+}
+
+func flattenSingleRouterToGCPDestination(in connections.Destination) []interface{} {
+	var out []interface{}
+	m := make(map[string]interface{})
+
+	primary := make(map[string]interface{})
+	primary["interconnect"] = in.Primary.Interconnect
+	primary["pairing_key"] = in.Primary.PairingKey
+
+	m["primary"] = []interface{}{primary}
+	m["qos_type"] = in.QosType
+
+	out = append(out, m)
+	return out
+}
+
+// flattenASPathPrepend converts the API value of AS path prepends back to
+// the number of prepends, or OFF if prepending is turned off.
+func flattenASPathPrepend(in *interface{}) string {
+	if in == nil || *in == nil {
+		return "OFF"
+	}
+
+	return fmt.Sprintf("%v", *in)
+}
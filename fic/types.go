@@ -3,13 +3,18 @@ package fic
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"log"
+	"math/rand"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/nttcom/go-fic/fic/eri/v1/ports"
+	"golang.org/x/time/rate"
 )
 
 // LogRoundTripper satisfies the http.RoundTripper interface and is used to
@@ -17,6 +22,9 @@ import (
 type LogRoundTripper struct {
 	Rt      http.RoundTripper
 	OsDebug bool
+
+	// RedactKeys lists JSON keys to mask in addition to REDACT_JSON_KEYS.
+	RedactKeys []string
 }
 
 // RoundTrip performs a round-trip HTTP request and logs relevant information about it.
@@ -111,17 +119,6 @@ func (lrt *LogRoundTripper) formatJSON(raw []byte) string {
 		return string(raw)
 	}
 
-	// Mask known password fields
-	if v, ok := data["auth"].(map[string]interface{}); ok {
-		if v, ok := v["identity"].(map[string]interface{}); ok {
-			if v, ok := v["password"].(map[string]interface{}); ok {
-				if v, ok := v["user"].(map[string]interface{}); ok {
-					v["password"] = "***"
-				}
-			}
-		}
-	}
-
 	// Ignore the catalog
 	if v, ok := data["token"].(map[string]interface{}); ok {
 		if _, ok := v["catalog"]; ok {
@@ -129,6 +126,9 @@ func (lrt *LogRoundTripper) formatJSON(raw []byte) string {
 		}
 	}
 
+	// Mask known fields which contain credentials
+	RedactJSON(data, lrt.RedactKeys)
+
 	pretty, err := json.MarshalIndent(data, "", "  ")
 	if err != nil {
 		log.Printf("[DEBUG] Unable to re-marshal FIC JSON: %s", err)
@@ -138,6 +138,172 @@ func (lrt *LogRoundTripper) formatJSON(raw []byte) string {
 	return string(pretty)
 }
 
+// retryableStatusCodes lists the HTTP status codes which indicate that the
+// FIC API rejected the request at the moment, but may accept it if the same
+// request is sent again later.
+var retryableStatusCodes = []int{
+	http.StatusConflict,
+	http.StatusTooManyRequests,
+	http.StatusServiceUnavailable,
+}
+
+// idempotentRetryableStatusCodes lists the HTTP status codes which are only
+// retried for idempotent methods. The API may already have accepted a request
+// which failed with one of them, so retrying a POST could create a resource
+// twice.
+var idempotentRetryableStatusCodes = []int{
+	http.StatusInternalServerError,
+}
+
+// idempotentMethods lists the HTTP methods which can safely be sent again.
+var idempotentMethods = []string{
+	http.MethodGet,
+	http.MethodHead,
+	http.MethodPut,
+	http.MethodDelete,
+}
+
+// RetryRoundTripper satisfies the http.RoundTripper interface and retries
+// requests which failed with a retryable status code, using exponential
+// backoff with jitter between the attempts.
+type RetryRoundTripper struct {
+	Rt         http.RoundTripper
+	MaxRetries int
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+}
+
+// RoundTrip performs a round-trip HTTP request and retries it as long as the
+// response status code is retryable and MaxRetries is not exceeded.
+func (rrt *RetryRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		req := request
+		if attempt > 0 && request.Body != nil {
+			if request.GetBody == nil {
+				return nil, fmt.Errorf("unable to retry %s %s: request body can not be rewound", request.Method, request.URL)
+			}
+
+			body, err := request.GetBody()
+			if err != nil {
+				return nil, err
+			}
+
+			req = request.Clone(request.Context())
+			req.Body = body
+		}
+
+		response, err := rrt.Rt.RoundTrip(req)
+		if err != nil || attempt >= rrt.MaxRetries || !isRetryable(request.Method, response.StatusCode) {
+			return response, err
+		}
+
+		backoff := rrt.backoff(attempt, response)
+
+		log.Printf("[DEBUG] FIC API returned %d for %s %s, retrying in %s (%d/%d)",
+			response.StatusCode, request.Method, request.URL, backoff, attempt+1, rrt.MaxRetries)
+
+		io.Copy(ioutil.Discard, response.Body)
+		response.Body.Close()
+
+		select {
+		case <-request.Context().Done():
+			return nil, request.Context().Err()
+		case <-time.After(backoff):
+		}
+	}
+}
+
+// backoff returns the time to wait before the next attempt.
+// A Retry-After header sent by the API takes precedence over the
+// exponential backoff, but is still capped by MaxBackoff.
+func (rrt *RetryRoundTripper) backoff(attempt int, response *http.Response) time.Duration {
+	if v := response.Header.Get("Retry-After"); v != "" {
+		if seconds, err := strconv.Atoi(v); err == nil && seconds >= 0 {
+			return minDuration(time.Duration(seconds)*time.Second, rrt.MaxBackoff)
+		}
+	}
+
+	backoff := rrt.MinBackoff
+	for i := 0; i < attempt && backoff < rrt.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	backoff = minDuration(backoff, rrt.MaxBackoff)
+
+	// Apply "equal jitter" so that concurrent requests do not retry in lockstep.
+	if half := int64(backoff / 2); half > 0 {
+		backoff = time.Duration(half + rand.Int63n(half))
+	}
+
+	return backoff
+}
+
+func isRetryable(method string, code int) bool {
+	if containsInt(retryableStatusCodes, code) {
+		return true
+	}
+
+	return containsInt(idempotentRetryableStatusCodes, code) && containsString(idempotentMethods, method)
+}
+
+func containsInt(s []int, v int) bool {
+	for _, i := range s {
+		if i == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsString(s []string, v string) bool {
+	for _, i := range s {
+		if i == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+func minDuration(a, b time.Duration) time.Duration {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// RateLimitRoundTripper satisfies the http.RoundTripper interface and is used
+// to cap the rate and the number of concurrent requests sent to the FIC API.
+// A nil Limiter or Slots disables the respective limit.
+type RateLimitRoundTripper struct {
+	Rt      http.RoundTripper
+	Limiter *rate.Limiter
+	Slots   chan struct{}
+}
+
+// RoundTrip waits for a free slot and a token from the bucket before
+// performing the round-trip HTTP request.
+func (rlrt *RateLimitRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	ctx := request.Context()
+
+	if rlrt.Slots != nil {
+		select {
+		case rlrt.Slots <- struct{}{}:
+			defer func() { <-rlrt.Slots }()
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	if rlrt.Limiter != nil {
+		if err := rlrt.Limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return rlrt.Rt.RoundTrip(request)
+}
+
 /*
 For FIC specific resources definition
 */
@@ -0,0 +1,262 @@
+package fic
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+func testRetryServer(t *testing.T, codes []int, bodies *[]string) *httptest.Server {
+	var count int
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("Unable to read request body: %s", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		*bodies = append(*bodies, string(body))
+
+		code := codes[len(codes)-1]
+		if count < len(codes) {
+			code = codes[count]
+		}
+		count++
+
+		w.WriteHeader(code)
+	}))
+}
+
+func TestRetryRoundTripper_retryableStatus(t *testing.T) {
+	var bodies []string
+	server := testRetryServer(t, []int{409, 503, 429, 201}, &bodies)
+	defer server.Close()
+
+	client := http.Client{
+		Transport: &RetryRoundTripper{
+			Rt:         http.DefaultTransport,
+			MaxRetries: 5,
+			MinBackoff: time.Millisecond,
+			MaxBackoff: 4 * time.Millisecond,
+		},
+	}
+
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{"router":{}}`))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 201 {
+		t.Errorf("Expected status code 201, got %d", resp.StatusCode)
+	}
+
+	if len(bodies) != 4 {
+		t.Fatalf("Expected 4 requests, got %d", len(bodies))
+	}
+
+	for i, body := range bodies {
+		if body != `{"router":{}}` {
+			t.Errorf("Expected request %d to resend the body, got %q", i, body)
+		}
+	}
+}
+
+func TestRetryRoundTripper_maxRetries(t *testing.T) {
+	var bodies []string
+	server := testRetryServer(t, []int{503}, &bodies)
+	defer server.Close()
+
+	client := http.Client{
+		Transport: &RetryRoundTripper{
+			Rt:         http.DefaultTransport,
+			MaxRetries: 2,
+			MinBackoff: time.Millisecond,
+			MaxBackoff: time.Millisecond,
+		},
+	}
+
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 503 {
+		t.Errorf("Expected status code 503, got %d", resp.StatusCode)
+	}
+
+	if len(bodies) != 3 {
+		t.Errorf("Expected 3 requests, got %d", len(bodies))
+	}
+}
+
+func TestRetryRoundTripper_nonRetryableStatus(t *testing.T) {
+	var bodies []string
+	server := testRetryServer(t, []int{400, 201}, &bodies)
+	defer server.Close()
+
+	client := http.Client{
+		Transport: &RetryRoundTripper{
+			Rt:         http.DefaultTransport,
+			MaxRetries: 5,
+			MinBackoff: time.Millisecond,
+			MaxBackoff: time.Millisecond,
+		},
+	}
+
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 400 {
+		t.Errorf("Expected status code 400, got %d", resp.StatusCode)
+	}
+
+	if len(bodies) != 1 {
+		t.Errorf("Expected 1 request, got %d", len(bodies))
+	}
+}
+
+func TestRetryRoundTripper_internalServerError(t *testing.T) {
+	client := http.Client{
+		Transport: &RetryRoundTripper{
+			Rt:         http.DefaultTransport,
+			MaxRetries: 5,
+			MinBackoff: time.Millisecond,
+			MaxBackoff: time.Millisecond,
+		},
+	}
+
+	var getBodies []string
+	getServer := testRetryServer(t, []int{500, 200}, &getBodies)
+	defer getServer.Close()
+
+	resp, err := client.Get(getServer.URL)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != 200 || len(getBodies) != 2 {
+		t.Errorf("Expected GET to be retried once and succeed, got status code %d after %d requests", resp.StatusCode, len(getBodies))
+	}
+
+	var postBodies []string
+	postServer := testRetryServer(t, []int{500, 201}, &postBodies)
+	defer postServer.Close()
+
+	resp, err = client.Post(postServer.URL, "application/json", strings.NewReader(`{"connection":{}}`))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != 500 || len(postBodies) != 1 {
+		t.Errorf("Expected POST not to be retried, got status code %d after %d requests", resp.StatusCode, len(postBodies))
+	}
+}
+
+func TestRetryRoundTripper_backoff(t *testing.T) {
+	rrt := &RetryRoundTripper{
+		MinBackoff: time.Second,
+		MaxBackoff: 8 * time.Second,
+	}
+	resp := &http.Response{Header: http.Header{}}
+
+	for attempt, max := range []time.Duration{1, 2, 4, 8, 8} {
+		max *= time.Second
+		backoff := rrt.backoff(attempt, resp)
+		if backoff < max/2 || backoff > max {
+			t.Errorf("Expected backoff of attempt %d to be between %s and %s, got %s", attempt, max/2, max, backoff)
+		}
+	}
+
+	resp.Header.Set("Retry-After", "3")
+	if backoff := rrt.backoff(0, resp); backoff != 3*time.Second {
+		t.Errorf("Expected Retry-After to be honored, got %s", backoff)
+	}
+
+	resp.Header.Set("Retry-After", "60")
+	if backoff := rrt.backoff(0, resp); backoff != rrt.MaxBackoff {
+		t.Errorf("Expected Retry-After to be capped by MaxBackoff, got %s", backoff)
+	}
+}
+
+func TestRateLimitRoundTripper_maxConcurrent(t *testing.T) {
+	var inFlight, maxInFlight int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if n <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		atomic.AddInt32(&inFlight, -1)
+	}))
+	defer server.Close()
+
+	client := http.Client{
+		Transport: &RateLimitRoundTripper{
+			Rt:    http.DefaultTransport,
+			Slots: make(chan struct{}, 2),
+		},
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := client.Get(server.URL)
+			if err != nil {
+				t.Errorf("Unexpected error: %s", err)
+				return
+			}
+			resp.Body.Close()
+		}()
+	}
+	wg.Wait()
+
+	if maxInFlight > 2 {
+		t.Errorf("Expected at most 2 concurrent requests, got %d", maxInFlight)
+	}
+}
+
+func TestRateLimitRoundTripper_requestsPerSecond(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	client := http.Client{
+		Transport: &RateLimitRoundTripper{
+			Rt:      http.DefaultTransport,
+			Limiter: rate.NewLimiter(rate.Limit(50), 1),
+		},
+	}
+
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		resp, err := client.Get(server.URL)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		resp.Body.Close()
+	}
+
+	// The first request consumes the initial token, the remaining four
+	// have to wait 20ms each.
+	if elapsed := time.Since(start); elapsed < 80*time.Millisecond {
+		t.Errorf("Expected 5 requests at 50 requests per second to take at least 80ms, took %s", elapsed)
+	}
+}
@@ -1,17 +1,25 @@
 package fic
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"io/ioutil"
+	"log"
 	"net/http"
+	"os"
+	"path/filepath"
 	"sort"
 	"strings"
 	"time"
 
 	"github.com/nttcom/go-fic"
+	"github.com/nttcom/go-fic/fic/eri/v1/operations"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/unknwon/com"
 )
 
@@ -40,6 +48,100 @@ func CheckDeleted(d *schema.ResourceData, err error, msg string) error {
 	return fmt.Errorf("%s: %w", msg, err)
 }
 
+// readPathOrContents returns the contents of the file at poc, if poc is a
+// path to an existing file. Otherwise, poc is returned as is.
+// The second return value indicates whether a file was read.
+func readPathOrContents(poc string) (string, bool, error) {
+	if len(poc) == 0 {
+		return poc, false, nil
+	}
+
+	path := poc
+	if strings.HasPrefix(path, "~/") {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return path, true, err
+		}
+		path = filepath.Join(home, path[2:])
+	}
+
+	if _, err := os.Stat(path); err == nil {
+		contents, err := ioutil.ReadFile(path)
+		return string(contents), true, err
+	}
+
+	return poc, false, nil
+}
+
+// attributeError returns an error diagnostic which points at the argument
+// that caused the error. An empty attribute leaves the path unset.
+func attributeError(attribute, summary string, err error) diag.Diagnostics {
+	var path cty.Path
+	if attribute != "" {
+		path = cty.GetAttrPath(attribute)
+	}
+
+	return diag.Diagnostics{
+		{
+			Severity:      diag.Error,
+			Summary:       summary,
+			Detail:        err.Error(),
+			AttributePath: path,
+		},
+	}
+}
+
+// changedAttribute returns the first of the given arguments which has a
+// change, so that an error of an update can point at it.
+func changedAttribute(d *schema.ResourceData, keys ...string) string {
+	for _, k := range keys {
+		if d.HasChange(k) {
+			return k
+		}
+	}
+
+	return ""
+}
+
+// operationStatusWarning returns a warning if a resource was left in an
+// operation status other than Completed, for example because an operation
+// failed after Terraform stopped waiting for it.
+func operationStatusWarning(kind, id, status string) diag.Diagnostics {
+	if status == "" || status == "Completed" {
+		return nil
+	}
+
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("FIC %s %s is in an unexpected operation status", kind, id),
+			Detail: fmt.Sprintf("The operation status of %s %s is %q instead of \"Completed\". "+
+				"The last operation on it may have failed.", kind, id, status),
+		},
+	}
+}
+
+// operationError extends err, returned for a resource whose operation status
+// is Error, with the error message of the failed operation. err is returned
+// as is if the operation can not be retrieved.
+func operationError(client *fic.ServiceClient, operationID string, err error) error {
+	if operationID == "" {
+		return err
+	}
+
+	op, opErr := operations.Get(client, operationID).Extract()
+	if opErr != nil {
+		log.Printf("[DEBUG] Unable to retrieve operation %s: %s", operationID, opErr)
+		return err
+	}
+
+	if op.Error == "" {
+		return err
+	}
+
+	return fmt.Errorf("%w (operation %s: %s)", err, operationID, op.Error)
+}
+
 // GetRegion returns the region that was specified in the resource. If a
 // region was not set, the provider-level region is checked. The provider-level
 // region can either be set by the region argument or by OS_REGION_NAME.
@@ -93,6 +195,52 @@ func RedactHeaders(headers http.Header) (processedHeaders []string) {
 	return
 }
 
+// List of JSON keys whose values need to be redacted.
+// Keys are matched case-insensitively at any depth of a body.
+var REDACT_JSON_KEYS = []string{"password", "secret", "token", "application_credential",
+	"eclApiKey", "eclApiSecretKey", "destinationEclApiKey", "destinationEclApiSecretKey",
+	"sharedKey", "serviceKey", "pairingKey"}
+
+// RedactJSON walks a decoded JSON value and masks every value stored under
+// one of REDACT_JSON_KEYS or the given extra keys, including whole objects
+// and arrays. Objects and arrays are modified in place.
+func RedactJSON(data interface{}, extraKeys []string) interface{} {
+	keys := make([]string, 0, len(REDACT_JSON_KEYS)+len(extraKeys))
+	keys = append(keys, REDACT_JSON_KEYS...)
+	keys = append(keys, extraKeys...)
+
+	return redactJSON(data, keys)
+}
+
+func redactJSON(data interface{}, keys []string) interface{} {
+	switch v := data.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if value != nil && isRedactedJSONKey(key, keys) {
+				v[key] = "***"
+				continue
+			}
+
+			v[key] = redactJSON(value, keys)
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = redactJSON(value, keys)
+		}
+	}
+
+	return data
+}
+
+func isRedactedJSONKey(key string, keys []string) bool {
+	for _, k := range keys {
+		if strings.EqualFold(k, key) {
+			return true
+		}
+	}
+	return false
+}
+
 // FormatHeaders processes a headers object plus a deliminator, returning a string
 func FormatHeaders(headers http.Header, seperator string) string {
 	redactedHeaders := RedactHeaders(headers)
@@ -101,20 +249,33 @@ func FormatHeaders(headers http.Header, seperator string) string {
 	return strings.Join(redactedHeaders, seperator)
 }
 
-func checkForRetryableError(err error) *resource.RetryError {
-	switch errCode := err.(type) {
-	case fic.ErrDefault500:
-		return resource.RetryableError(err)
-	case fic.ErrUnexpectedResponseCode:
-		switch errCode.Actual {
-		case 409, 503:
-			return resource.RetryableError(err)
-		default:
-			return resource.NonRetryableError(err)
-		}
-	default:
-		return resource.NonRetryableError(err)
+// hashedSecretPrefix marks a secret which is stored as a hash in the state.
+const hashedSecretPrefix = "sha256:"
+
+// hashSecret returns the representation of a secret which is stored in the
+// state instead of the secret itself when write_only_secrets is set.
+func hashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hashedSecretPrefix + hex.EncodeToString(sum[:])
+}
+
+// secretForState returns the value to store in the state for a secret,
+// which is a hash of it if write_only_secrets is set.
+// A change of write_only_secrets is applied by the next Read, which needs
+// the secret itself. Resources which can not read their secrets back from
+// the API must therefore make write_only_secrets ForceNew.
+func secretForState(d *schema.ResourceData, secret string) string {
+	if secret == "" || strings.HasPrefix(secret, hashedSecretPrefix) || !d.Get("write_only_secrets").(bool) {
+		return secret
 	}
+
+	return hashSecret(secret)
+}
+
+// suppressHashedSecretDiff suppresses the diff between a secret stored as a
+// hash in the state and the same secret in the configuration.
+func suppressHashedSecretDiff(k, old, new string, d *schema.ResourceData) bool {
+	return strings.HasPrefix(old, hashedSecretPrefix) && old == hashSecret(new)
 }
 
 func suppressEquivilentTimeDiffs(k, old, new string, d *schema.ResourceData) bool {
@@ -0,0 +1,261 @@
+package fic
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nttcom/terraform-provider-fic/fic/testhelper/mock"
+)
+
+func TestRedactJSON(t *testing.T) {
+	cases := map[string]struct {
+		in        string
+		extraKeys []string
+		expected  string
+	}{
+		"identity password": {
+			in:       `{"auth":{"identity":{"methods":["password"],"password":{"user":{"name":"jdoe","password":"secret"}}}}}`,
+			expected: `{"auth":{"identity":{"methods":["password"],"password":"***"}}}`,
+		},
+		"application credential": {
+			in:       `{"auth":{"identity":{"application_credential":{"id":"abc","secret":"secret"}}}}`,
+			expected: `{"auth":{"identity":{"application_credential":"***"}}}`,
+		},
+		"nested token ID": {
+			in:       `{"auth":{"identity":{"methods":["token"],"token":{"id":"gAAAAABf"}},"scope":{"project":{"id":"p"}}}}`,
+			expected: `{"auth":{"identity":{"methods":["token"],"token":"***"},"scope":{"project":{"id":"p"}}}}`,
+		},
+		"secret object and array": {
+			in:       `{"connection":{"secret":{"key":"a"},"sharedKey":["b","c"]}}`,
+			expected: `{"connection":{"secret":"***","sharedKey":"***"}}`,
+		},
+		"ECL connection": {
+			in:       `{"connection":{"destination":{"eclTenantId":"t","eclApiKey":"key","eclApiSecretKey":"secret"}}}`,
+			expected: `{"connection":{"destination":{"eclApiKey":"***","eclApiSecretKey":"***","eclTenantId":"t"}}}`,
+		},
+		"Azure connection": {
+			in:       `{"connection":{"destination":{"primary":{"sharedKey":"a"},"secondary":{"sharedKey":"b"},"serviceKey":"c"}}}`,
+			expected: `{"connection":{"destination":{"primary":{"sharedKey":"***"},"secondary":{"sharedKey":"***"},"serviceKey":"***"}}}`,
+		},
+		"GCP connections in a list": {
+			in:       `{"connections":[{"destination":{"primary":{"pairingKey":"a"}}},{"destination":{"primary":{"pairingKey":"b"}}}]}`,
+			expected: `{"connections":[{"destination":{"primary":{"pairingKey":"***"}}},{"destination":{"primary":{"pairingKey":"***"}}}]}`,
+		},
+		"case insensitive": {
+			in:       `{"SharedKey":"a","PASSWORD":"b"}`,
+			expected: `{"PASSWORD":"***","SharedKey":"***"}`,
+		},
+		"null and empty values": {
+			in:       `{"sharedKey":null,"serviceKey":""}`,
+			expected: `{"serviceKey":"***","sharedKey":null}`,
+		},
+		"extra keys": {
+			in:        `{"router":{"name":"r","description":"internal"}}`,
+			extraKeys: []string{"description"},
+			expected:  `{"router":{"description":"***","name":"r"}}`,
+		},
+		"unrelated keys": {
+			in:       `{"router":{"id":"abc","name":"r"}}`,
+			expected: `{"router":{"id":"abc","name":"r"}}`,
+		},
+	}
+
+	for name, c := range cases {
+		var data interface{}
+		if err := json.Unmarshal([]byte(c.in), &data); err != nil {
+			t.Fatalf("%s: %s", name, err)
+		}
+
+		actual, err := json.Marshal(RedactJSON(data, c.extraKeys))
+		if err != nil {
+			t.Fatalf("%s: %s", name, err)
+		}
+
+		if string(actual) != c.expected {
+			t.Errorf("%s: expected %s, got %s", name, c.expected, actual)
+		}
+	}
+}
+
+func TestLogRoundTripperFormatJSON(t *testing.T) {
+	lrt := &LogRoundTripper{RedactKeys: []string{"name"}}
+
+	actual := lrt.formatJSON([]byte(`{"connection":{"name":"c","destination":{"sharedKey":"s"}}}`))
+	expected := `{
+  "connection": {
+    "destination": {
+      "sharedKey": "***"
+    },
+    "name": "***"
+  }
+}`
+
+	if actual != expected {
+		t.Errorf("Expected %s, got %s", expected, actual)
+	}
+}
+
+func TestHashedSecret(t *testing.T) {
+	hashed := hashSecret("secret")
+	if hashed != "sha256:2bb80d537b1da3e38bd30361aa855686bde0eacd7162fef6a25fe97bf527a25b" {
+		t.Errorf("Unexpected hash %s", hashed)
+	}
+
+	if !suppressHashedSecretDiff("destination_shared_key", hashed, "secret", nil) {
+		t.Error("Expected the diff between a hashed secret and the secret to be suppressed")
+	}
+
+	if suppressHashedSecretDiff("destination_shared_key", hashed, "other", nil) {
+		t.Error("Expected the diff between a hashed secret and another secret not to be suppressed")
+	}
+
+	if suppressHashedSecretDiff("destination_shared_key", "secret", "other", nil) {
+		t.Error("Expected the diff between plain secrets not to be suppressed")
+	}
+}
+
+func TestSecretForState(t *testing.T) {
+	r := resourceEriRouterToECLConnectionV1()
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{})
+	if v := secretForState(d, "secret"); v != "secret" {
+		t.Errorf("Expected the secret to be stored as is, got %s", v)
+	}
+
+	d = schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"write_only_secrets": true,
+	})
+	if v := secretForState(d, "secret"); v != hashSecret("secret") {
+		t.Errorf("Expected the secret to be hashed, got %s", v)
+	}
+
+	if v := secretForState(d, hashSecret("secret")); v != hashSecret("secret") {
+		t.Errorf("Expected a hashed secret not to be hashed again, got %s", v)
+	}
+
+	if v := secretForState(d, ""); v != "" {
+		t.Errorf("Expected an empty secret to stay empty, got %s", v)
+	}
+}
+
+func TestOperationStatusWarning(t *testing.T) {
+	if diags := operationStatusWarning("connection", "F0123", "Completed"); diags != nil {
+		t.Errorf("Expected no warning for a completed connection, got %#v", diags)
+	}
+
+	diags := operationStatusWarning("connection", "F0123", "Error")
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("Expected a warning for a connection in Error, got %#v", diags)
+	}
+
+	if diags.HasError() {
+		t.Error("Expected an unexpected operation status not to fail the run")
+	}
+}
+
+const testMockEriV1OperationError = `
+request:
+  method: GET
+response:
+  code: 200
+  body: >
+    {
+      "operation": {
+        "id": "cc43d0f05df24b1aabdea46456d46e39",
+        "resourceId": "F022000000000001",
+        "resourceType": "Router",
+        "requestType": "Create",
+        "status": "Error",
+        "error": "user ip address overlaps with an existing router"
+      }
+    }
+`
+
+const testMockEriV1OperationNotFound = `
+request:
+  method: GET
+response:
+  code: 404
+`
+
+func TestOperationError(t *testing.T) {
+	mc, config := testConfigWithMockKeystone(t, func(mc *mock.MockController) {
+		mc.Register(t, "operation", "/public/v1/operations/cc43d0f05df24b1aabdea46456d46e39", testMockEriV1OperationError)
+		mc.Register(t, "missing", "/public/v1/operations/cc43d0f05df24b1aabdea46456d46e40", testMockEriV1OperationNotFound)
+	})
+	defer mc.TerminateMockControllerSafety()
+
+	if err := config.LoadAndValidate(); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	client, err := config.eriV1Client("")
+	if err != nil {
+		t.Fatalf("Unable to create ERI client: %s", err)
+	}
+
+	base := errors.New("There was an error retrieving the router information.")
+
+	cases := map[string]struct {
+		operationID string
+		expected    string
+	}{
+		"operation with error": {
+			operationID: "cc43d0f05df24b1aabdea46456d46e39",
+			expected: "There was an error retrieving the router information. " +
+				"(operation cc43d0f05df24b1aabdea46456d46e39: user ip address overlaps with an existing router)",
+		},
+		"operation not found": {
+			operationID: "cc43d0f05df24b1aabdea46456d46e40",
+			expected:    base.Error(),
+		},
+		"no operation": {
+			expected: base.Error(),
+		},
+	}
+
+	for name, c := range cases {
+		err := operationError(client, c.operationID, base)
+		if err.Error() != c.expected {
+			t.Errorf("%s: expected %q, got %q", name, c.expected, err)
+		}
+
+		if !errors.Is(err, base) {
+			t.Errorf("%s: expected the error to wrap the original one", name)
+		}
+	}
+}
+
+func TestAttributeError(t *testing.T) {
+	diags := attributeError("bandwidth", "Error updating FIC ERI connection", errors.New("conflict"))
+	if !diags[0].AttributePath.Equals(cty.GetAttrPath("bandwidth")) {
+		t.Errorf("Expected the error to point at bandwidth, got %#v", diags[0].AttributePath)
+	}
+
+	if diags[0].Detail != "conflict" {
+		t.Errorf("Expected the detail to be the error, got %q", diags[0].Detail)
+	}
+
+	if diags := attributeError("", "Error", errors.New("conflict")); diags[0].AttributePath != nil {
+		t.Errorf("Expected no attribute path, got %#v", diags[0].AttributePath)
+	}
+}
+
+func TestChangedAttribute(t *testing.T) {
+	// Every configured argument is a change from the empty state.
+	d := schema.TestResourceDataRaw(t, resourceEriRouterToAWSConnectionV1().Schema, map[string]interface{}{
+		"source_route_filter_out": "noRoute",
+	})
+
+	if actual := changedAttribute(d, "source_route_filter_in", "source_route_filter_out"); actual != "source_route_filter_out" {
+		t.Errorf("Expected source_route_filter_out, got %q", actual)
+	}
+
+	if actual := changedAttribute(d, "source_route_filter_in", "bandwidth"); actual != "" {
+		t.Errorf("Expected no changed attribute, got %q", actual)
+	}
+}
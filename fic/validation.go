@@ -3,8 +3,9 @@ package fic
 import (
 	"fmt"
 	"strconv"
+	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // IntInSlice returns a SchemaValidateFunc which tests if the provided value
@@ -56,3 +57,27 @@ func ValidateVRID() schema.SchemaValidateFunc {
 		return
 	}
 }
+
+// ValidateDuration returns a SchemaValidateFunc which tests if the provided
+// value is a non-negative duration string parsable by time.ParseDuration
+func ValidateDuration() schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (s []string, es []error) {
+		v, ok := i.(string)
+		if !ok {
+			es = append(es, fmt.Errorf("expected type of %s to be a string", k))
+			return
+		}
+
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			es = append(es, fmt.Errorf("expected %s to be a duration, got %s: %s", k, v, err))
+			return
+		}
+
+		if d < 0 {
+			es = append(es, fmt.Errorf("expected %s to be a non-negative duration, got %s", k, v))
+		}
+
+		return
+	}
+}
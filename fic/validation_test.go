@@ -4,7 +4,7 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 type testCase struct {
@@ -60,3 +60,31 @@ func TestValidationIntInSlice(t *testing.T) {
 		},
 	})
 }
+
+func TestValidationDuration(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: "1s",
+			f:   ValidateDuration(),
+		},
+		{
+			val: "1m30s",
+			f:   ValidateDuration(),
+		},
+		{
+			val:         "10",
+			f:           ValidateDuration(),
+			expectedErr: regexp.MustCompile("expected [\\w]+ to be a duration, got 10"),
+		},
+		{
+			val:         "-1s",
+			f:           ValidateDuration(),
+			expectedErr: regexp.MustCompile("expected [\\w]+ to be a non-negative duration, got -1s"),
+		},
+		{
+			val:         1,
+			f:           ValidateDuration(),
+			expectedErr: regexp.MustCompile("expected type of [\\w]+ to be a string"),
+		},
+	})
+}